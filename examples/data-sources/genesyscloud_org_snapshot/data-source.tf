@@ -0,0 +1,11 @@
+data "genesyscloud_org_snapshot" "org" {
+  include_filter_resources = ["genesyscloud_user", "genesyscloud_routing_queue"]
+}
+
+output "users_without_location" {
+  value = length([for user in jsondecode(data.genesyscloud_org_snapshot.org.resources["genesyscloud_user"]) : user.email if !can(user.locations)])
+}
+
+output "queue_count" {
+  value = data.genesyscloud_org_snapshot.org.resource_counts["genesyscloud_routing_queue"]
+}
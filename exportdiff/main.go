@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"terraform-provider-genesyscloud/genesyscloud/tfexporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Compares two export directories and writes the added, removed and changed resources to export_diff.md and export_diff.json
+//
+//	terraform providers schema -json > schema.json
+//	go run terraform-provider-genesyscloud/exportdiff -from ./prod -to ./staging -out . -schema schema.json
+func main() {
+	var fromDir, toDir, outputDir, schemaFile string
+	flag.StringVar(&fromDir, "from", "", "the export directory to compare from")
+	flag.StringVar(&toDir, "to", "", "the export directory to compare to")
+	flag.StringVar(&outputDir, "out", ".", "the directory the diff files are written to")
+	flag.StringVar(&schemaFile, "schema", "", "the output of terraform providers schema -json, used to compare set blocks regardless of their order")
+	flag.Parse()
+
+	if fromDir == "" || toDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var resources map[string]*schema.Resource
+	if schemaFile != "" {
+		var err error
+		if resources, err = tfexporter.ReadProviderSchemaFile(schemaFile); err != nil {
+			log.Fatalf("Failed to read the provider schema: %v", err)
+		}
+	}
+
+	exportDiff, diagErr := tfexporter.DiffExportDirectories(fromDir, toDir, outputDir, resources)
+	if diagErr != nil {
+		log.Fatalf("Failed to compare %s and %s: %v", fromDir, toDir, diagErr)
+	}
+	fmt.Printf("%d added, %d removed, %d changed\n", len(exportDiff.Added), len(exportDiff.Removed), len(exportDiff.Changed))
+}
@@ -5,13 +5,17 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *architectDatatableProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectDatatableProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOrUpdateArchitectDatatableFunc func(ctx context.Context, p *architectDatatableProxy, createAction bool, datatable *Datatable) (*Datatable, *platformclientv2.APIResponse, error)
 type deleteArchitectDatatableFunc func(ctx context.Context, p *architectDatatableProxy, datatableId string) (*platformclientv2.APIResponse, error)
@@ -40,11 +44,10 @@ func newArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *a
 }
 
 func getArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *architectDatatableProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectDatatableProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectDatatableProxy)
 }
 
 func (p *architectDatatableProxy) createArchitectDatatable(ctx context.Context, datatable *Datatable) (*Datatable, *platformclientv2.APIResponse, error) {
@@ -8,12 +8,16 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *architectDatatableRowProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectDatatableRowProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error)
 type getAllArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error)
@@ -39,8 +43,8 @@ type architectDatatableRowProxy struct {
 
 func newArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	dataTableRowCache := rc.NewResourceCache[map[string]interface{}]()
-	dataTableCache := rc.NewResourceCache[Datatable]()
+	dataTableRowCache := rc.GetOrgResourceCache[map[string]interface{}](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_architect_datatable_row")
+	dataTableCache := rc.GetOrgResourceCache[Datatable](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_architect_datatable")
 	return &architectDatatableRowProxy{
 		clientConfig:                     clientConfig,
 		architectApi:                     api,
@@ -57,10 +61,10 @@ func newArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration)
 }
 
 func getArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectDatatableRowProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectDatatableRowProxy)
 }
 
 func (p *architectDatatableRowProxy) getArchitectDatatable(ctx context.Context, id string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 var internalProxy *architectEmergencyGroupProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectEmergencyGroupProxy]
+
 type createArchitectEmergencyGroupFunc func(ctx context.Context, p *architectEmergencyGroupProxy, emergencyGroup platformclientv2.Emergencygroup) (*platformclientv2.Emergencygroup, *platformclientv2.APIResponse, error)
 type getAllArchitectEmergencyGroupFunc func(ctx context.Context, p *architectEmergencyGroupProxy) (*[]platformclientv2.Emergencygroup, *platformclientv2.APIResponse, error)
 type getArchitectEmergencyGroupFunc func(ctx context.Context, p *architectEmergencyGroupProxy, emergencyGroupId string) (emergencyGroup *platformclientv2.Emergencygroup, apiResponse *platformclientv2.APIResponse, err error)
@@ -41,11 +45,10 @@ func newArchitectEmergencyGroupProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getArchitectEmergencyGroupProxy(clientConfig *platformclientv2.Configuration) *architectEmergencyGroupProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectEmergencyGroupProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectEmergencyGroupProxy)
 }
 
 func (p *architectEmergencyGroupProxy) getAllArchitectEmergencyGroups(ctx context.Context) (*[]platformclientv2.Emergencygroup, *platformclientv2.APIResponse, error) {
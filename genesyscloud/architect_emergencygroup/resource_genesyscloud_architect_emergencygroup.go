@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
@@ -32,7 +33,11 @@ func getAllEmergencyGroups(ctx context.Context, clientConfig *platformclientv2.C
 
 	for _, emergencyGroupConfig := range *emergencyGroupConfigs {
 		if emergencyGroupConfig.State != nil && *emergencyGroupConfig.State != "deleted" {
-			resources[*emergencyGroupConfig.Id] = &resourceExporter.ResourceMeta{Name: *emergencyGroupConfig.Name}
+			resourceMeta := &resourceExporter.ResourceMeta{Name: *emergencyGroupConfig.Name}
+			if emergencyGroupConfig.Version != nil {
+				resourceMeta.Version = strconv.Itoa(*emergencyGroupConfig.Version)
+			}
+			resources[*emergencyGroupConfig.Id] = resourceMeta
 		}
 	}
 	return resources, nil
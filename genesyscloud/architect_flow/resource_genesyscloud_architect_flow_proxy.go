@@ -2,20 +2,37 @@ package architect_flow
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"log"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 )
 
 var internalProxy *architectFlowProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectFlowProxy]
+
 type getArchitectFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error)
 type forceUnlockFlowFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.APIResponse, error)
 type deleteArchitectFlowFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.APIResponse, error)
 type createArchitectFlowJobsFunc func(context.Context, *architectFlowProxy) (*platformclientv2.Registerarchitectjobresponse, *platformclientv2.APIResponse, error)
 type getArchitectFlowJobsFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Architectjobstateresponse, *platformclientv2.APIResponse, error)
 type getAllArchitectFlowsFunc func(context.Context, *architectFlowProxy) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error)
+type createArchitectFlowExportJobFunc func(context.Context, *architectFlowProxy, string) (*flowExportJob, *platformclientv2.APIResponse, error)
+type getArchitectFlowExportJobFunc func(context.Context, *architectFlowProxy, string) (*flowExportJob, *platformclientv2.APIResponse, error)
+
+// flowExportJob is the non-sdk model for an Architect flow export job. The export job APIs are not yet available in the Go SDK.
+type flowExportJob struct {
+	Id          *string                                 `json:"id,omitempty"`
+	Status      *string                                 `json:"status,omitempty"`
+	DownloadUrl *string                                 `json:"downloadUrl,omitempty"`
+	Messages    *[]platformclientv2.Architectjobmessage `json:"messages,omitempty"`
+}
 
 type architectFlowProxy struct {
 	clientConfig *platformclientv2.Configuration
@@ -27,13 +44,15 @@ type architectFlowProxy struct {
 	deleteArchitectFlowAttr     deleteArchitectFlowFunc
 	createArchitectFlowJobsAttr createArchitectFlowJobsFunc
 	getArchitectFlowJobsAttr    getArchitectFlowJobsFunc
+	createFlowExportJobAttr     createArchitectFlowExportJobFunc
+	getFlowExportJobAttr        getArchitectFlowExportJobFunc
 
 	flowCache rc.CacheInterface[platformclientv2.Flow]
 }
 
 func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *architectFlowProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	flowCache := rc.NewResourceCache[platformclientv2.Flow]()
+	flowCache := rc.GetOrgResourceCache[platformclientv2.Flow](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_flow")
 	return &architectFlowProxy{
 		clientConfig: clientConfig,
 		api:          api,
@@ -44,15 +63,17 @@ func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *archit
 		deleteArchitectFlowAttr:     deleteArchitectFlowFn,
 		createArchitectFlowJobsAttr: createArchitectFlowJobsFn,
 		getArchitectFlowJobsAttr:    getArchitectFlowJobsFn,
+		createFlowExportJobAttr:     createArchitectFlowExportJobFn,
+		getFlowExportJobAttr:        getArchitectFlowExportJobFn,
 		flowCache:                   flowCache,
 	}
 }
 
 func getArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *architectFlowProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectFlowProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectFlowProxy)
 }
 
 func (a *architectFlowProxy) GetFlow(ctx context.Context, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
@@ -75,6 +96,14 @@ func (a *architectFlowProxy) GetFlowsDeployJob(ctx context.Context, jobId string
 	return a.getArchitectFlowJobsAttr(ctx, a, jobId)
 }
 
+func (a *architectFlowProxy) CreateFlowExportJob(ctx context.Context, flowId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	return a.createFlowExportJobAttr(ctx, a, flowId)
+}
+
+func (a *architectFlowProxy) GetFlowExportJob(ctx context.Context, jobId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	return a.getFlowExportJobAttr(ctx, a, jobId)
+}
+
 func (a *architectFlowProxy) GetAllFlows(ctx context.Context) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error) {
 	return a.getAllArchitectFlowsAttr(ctx, a)
 }
@@ -136,3 +165,45 @@ func getAllArchitectFlowsFn(ctx context.Context, p *architectFlowProxy) (*[]plat
 
 	return &totalFlows, nil, nil
 }
+
+func createArchitectFlowExportJobFn(_ context.Context, p *architectFlowProxy, flowId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	body := map[string]interface{}{
+		"flows": []map[string]string{{"id": flowId}},
+	}
+	return sdkCallFlowExportJobApi(p.clientConfig, http.MethodPost, "/api/v2/flows/export/jobs", body, nil)
+}
+
+func getArchitectFlowExportJobFn(_ context.Context, p *architectFlowProxy, jobId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	queryParams := map[string]string{"expand": "messages"}
+	return sdkCallFlowExportJobApi(p.clientConfig, http.MethodGet, "/api/v2/flows/export/jobs/"+jobId, nil, queryParams)
+}
+
+// sdkCallFlowExportJobApi is the non-sdk helper method for calling the Architect flow export job APIs
+func sdkCallFlowExportJobApi(config *platformclientv2.Configuration, method, resourcePath string, body interface{}, queryParams map[string]string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	apiClient := &config.APIClient
+
+	// create path and map variables
+	path := config.BasePath + resourcePath
+
+	headerParams := make(map[string]string)
+
+	// add default headers if any
+	for key := range config.DefaultHeader {
+		headerParams[key] = config.DefaultHeader[key]
+	}
+
+	headerParams["Authorization"] = "Bearer " + config.AccessToken
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	var successPayload *flowExportJob
+	response, err := apiClient.CallAPI(path, method, body, headerParams, queryParams, nil, "", nil)
+	if err != nil {
+		// Nothing special to do here, but do avoid processing the response
+	} else if response.Error != nil {
+		err = errors.New(response.ErrorMessage)
+	} else {
+		err = json.Unmarshal(response.RawBody, &successPayload)
+	}
+	return successPayload, response, err
+}
@@ -24,12 +24,17 @@ func ArchitectFlowExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllFlows),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{},
+		// The flow definition is exported by the custom file writer. The variables are kept for flows that fail to export.
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"filepath": ResourceArchitectFlow().Schema["filepath"],
 		},
 		CustomFlowResolver: map[string]*resourceExporter.CustomFlowResolver{
 			"file_content_hash": {ResolverFunc: resourceExporter.FileContentHashResolver},
 		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: ArchitectFlowResolver,
+			SubDirectory:              "flows",
+		},
 	}
 }
 
@@ -0,0 +1,91 @@
+package architect_flow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitArchitectFlowResolver(t *testing.T) {
+	flowId := uuid.NewString()
+	jobId := uuid.NewString()
+	flowYaml := "inboundCall:\n  name: Unit Test Flow\n"
+	exportDir := t.TempDir()
+	subDir := "flows"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(flowYaml))
+	}))
+	defer server.Close()
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.createFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		return &flowExportJob{Id: &jobId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	getCalls := 0
+	flowProxy.getFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, jobId, id)
+		getCalls++
+		status := "Started"
+		if getCalls > 1 {
+			status = "Success"
+		}
+		downloadUrl := server.URL
+		return &flowExportJob{Id: &jobId, Status: &status, DownloadUrl: &downloadUrl}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	configMap := map[string]interface{}{}
+
+	err := ArchitectFlowResolver(flowId, exportDir, subDir, configMap, gc)
+	assert.Nil(t, err)
+
+	expectedPath := path.Join(subDir, fmt.Sprintf("flow-%s.yaml", flowId))
+	assert.Equal(t, expectedPath, configMap["filepath"])
+	assert.Equal(t, fmt.Sprintf(`${filesha256("%s")}`, expectedPath), configMap["file_content_hash"])
+
+	content, readErr := os.ReadFile(path.Join(exportDir, expectedPath))
+	assert.Nil(t, readErr)
+	assert.Equal(t, flowYaml, string(content))
+}
+
+func TestUnitArchitectFlowResolverJobFailure(t *testing.T) {
+	flowId := uuid.NewString()
+	jobId := uuid.NewString()
+	failureText := "flow could not be exported"
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.createFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+		return &flowExportJob{Id: &jobId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	flowProxy.getFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+		status := "Failure"
+		messages := []platformclientv2.Architectjobmessage{{Text: &failureText}}
+		return &flowExportJob{Id: &jobId, Status: &status, Messages: &messages}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	configMap := map[string]interface{}{}
+
+	err := ArchitectFlowResolver(flowId, t.TempDir(), "flows", configMap, gc)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), failureText)
+	assert.Nil(t, configMap["filepath"])
+}
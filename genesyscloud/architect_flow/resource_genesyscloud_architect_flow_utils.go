@@ -1,12 +1,19 @@
 package architect_flow
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
+	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
 )
 
 func isForceUnlockEnabled(d *schema.ResourceData) bool {
@@ -42,3 +49,85 @@ func GenerateFlowResource(resourceID, srcFile, fileContent string, forceUnlock b
 func setFileContentHashToNil(d *schema.ResourceData) {
 	_ = d.Set("file_content_hash", nil)
 }
+
+// ArchitectFlowResolver exports the published definition of a flow through the Architect export job API and writes the
+// YAML file to the export sub directory. The filepath and file_content_hash attributes are updated to point to the exported file.
+func ArchitectFlowResolver(flowId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	p := getArchitectFlowProxy(sdkConfig)
+	ctx := context.Background()
+
+	exportFileName := fmt.Sprintf("flow-%s.yaml", flowId)
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	downloadUrl, err := exportFlowDefinition(ctx, p, flowId)
+	if err != nil {
+		return err
+	}
+
+	if err := files.DownloadExportFile(fullPath, exportFileName, downloadUrl); err != nil {
+		return err
+	}
+
+	// Update filepath field in configMap to point to exported flow file
+	configMap["filepath"] = path.Join(subDirectory, exportFileName)
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDirectory, exportFileName))
+
+	return nil
+}
+
+// exportFlowDefinition registers an export job for a flow and waits for it to finish. The download URL of the exported
+// flow definition is returned once the job succeeds.
+func exportFlowDefinition(ctx context.Context, p *architectFlowProxy, flowId string) (string, error) {
+	exportJob, resp, err := p.CreateFlowExportJob(ctx, flowId)
+	if err != nil {
+		return "", fmt.Errorf("failed to register export job for flow %s: %v %v", flowId, err, resp)
+	}
+	if exportJob == nil || exportJob.Id == nil {
+		return "", fmt.Errorf("failed to register export job for flow %s: no job ID returned", flowId)
+	}
+	jobId := *exportJob.Id
+
+	downloadUrl := ""
+	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		exportJob, resp, err := p.GetFlowExportJob(ctx, jobId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error retrieving export job status. JobID: %s, error: %s %v", jobId, err, resp))
+		}
+
+		status := ""
+		if exportJob.Status != nil {
+			status = *exportJob.Status
+		}
+
+		switch status {
+		case "Success":
+			if exportJob.DownloadUrl == nil || *exportJob.DownloadUrl == "" {
+				return retry.NonRetryableError(fmt.Errorf("export job %s for flow %s did not return a download URL", jobId, flowId))
+			}
+			downloadUrl = *exportJob.DownloadUrl
+			return nil
+		case "Failure":
+			messages := make([]string, 0)
+			if exportJob.Messages != nil {
+				for _, m := range *exportJob.Messages {
+					if m.Text != nil {
+						messages = append(messages, *m.Text)
+					}
+				}
+			}
+			return retry.NonRetryableError(fmt.Errorf("export of flow %s failed. JobID: %s, tracing messages: %v", flowId, jobId, strings.Join(messages, "\n\n")))
+		}
+
+		return retry.RetryableError(fmt.Errorf("export job %s for flow %s has not finished", jobId, flowId))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return downloadUrl, nil
+}
@@ -37,12 +37,12 @@ func getAllFlows(ctx context.Context, clientConfig *platformclientv2.Configurati
 		overrideBCPNaming := os.Getenv("OVERRIDE_BCP_NAMING")
 
 		if overrideBCPNaming != "" {
-			resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.Name}
+			resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.Name, Version: flowVersion(flow)}
 			continue
 		}
 
 		//This is our go forward naming standard for flows.
-		resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.VarType + "_" + *flow.Name}
+		resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.VarType + "_" + *flow.Name, Version: flowVersion(flow)}
 	}
 
 	return resources, nil
@@ -212,3 +212,12 @@ func updateFile(filepath, content string) {
 
 	_, _ = file.WriteString(content)
 }
+
+// flowVersion returns the version of a flow reported by the flows listing. The name is included as a flow can be renamed
+// without publishing a new version.
+func flowVersion(flow platformclientv2.Flow) string {
+	if flow.PublishedVersion == nil || flow.PublishedVersion.Id == nil {
+		return ""
+	}
+	return *flow.Name + "/" + *flow.PublishedVersion.Id
+}
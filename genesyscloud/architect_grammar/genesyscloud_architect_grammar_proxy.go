@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
@@ -15,9 +16,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *architectGrammarProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectGrammarProxy]
+
 // Type definitions for each func on our proxy so that we can easily mock them out later
 type createArchitectGrammarFunc func(ctx context.Context, p *architectGrammarProxy, grammar *platformclientv2.Grammar) (*platformclientv2.Grammar, *platformclientv2.APIResponse, error)
 type getAllArchitectGrammarFunc func(ctx context.Context, p *architectGrammarProxy) (*[]platformclientv2.Grammar, *platformclientv2.APIResponse, error)
@@ -42,7 +46,7 @@ type architectGrammarProxy struct {
 // newArchitectGrammarProxy initializes the grammar proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *architectGrammarProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	grammarCache := rc.NewResourceCache[platformclientv2.Grammar]()
+	grammarCache := rc.GetOrgResourceCache[platformclientv2.Grammar](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_architect_grammar")
 	return &architectGrammarProxy{
 		clientConfig:                    clientConfig,
 		architectApi:                    api,
@@ -56,14 +60,13 @@ func newArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *arc
 	}
 }
 
-// getArchitectGrammarProxy acts as a singleton for the internalProxy. It also ensures
+// getArchitectGrammarProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *architectGrammarProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectGrammarProxy)
 }
 
 // createArchitectGrammar creates a Genesys Cloud Architect Grammar
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util/files"
 	"time"
@@ -24,9 +25,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *architectGrammarLanguageProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectGrammarLanguageProxy]
+
 // Type definitions for each func on our proxy so that we can easily mock them out later
 type createArchitectGrammarLanguageFunc func(ctx context.Context, p *architectGrammarLanguageProxy, language *platformclientv2.Grammarlanguage) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error)
 type getArchitectGrammarLanguageByIdFunc func(ctx context.Context, p *architectGrammarLanguageProxy, grammarId string, languageCode string) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error)
@@ -49,7 +53,7 @@ type architectGrammarLanguageProxy struct {
 // newArchitectGrammarLanguageProxy initializes the grammar Language proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configuration) *architectGrammarLanguageProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	grammarLanguageCache := rc.NewResourceCache[platformclientv2.Grammarlanguage]()
+	grammarLanguageCache := rc.GetOrgResourceCache[platformclientv2.Grammarlanguage](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_architect_grammar_language")
 	return &architectGrammarLanguageProxy{
 		clientConfig:                        clientConfig,
 		architectApi:                        api,
@@ -62,14 +66,13 @@ func newArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getArchitectGrammarLanguageProxy acts as a singleton for the internalProxy. It also ensures
+// getArchitectGrammarLanguageProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configuration) *architectGrammarLanguageProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarLanguageProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectGrammarLanguageProxy)
 }
 
 // createArchitectGrammarLanguage creates a Genesys Cloud Architect Grammar Language
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	utillists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"time"
 
@@ -30,9 +31,12 @@ Each proxy implementation:
 7.  Function implementations for each function type definition.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *architectIvrProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectIvrProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createArchitectIvrFunc func(context.Context, *architectIvrProxy, platformclientv2.Ivr) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error)
 type getArchitectIvrFunc func(context.Context, *architectIvrProxy, string) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error)
@@ -81,13 +85,13 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 	}
 }
 
-// getArchitectIvrProxy acts as a singleton to for the internalProxy.  It also ensures
+// getArchitectIvrProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *architectIvrProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectIvrProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectIvrProxy)
 }
 
 // getAllArchitectIvrs retrieves all Genesys Cloud Architect IVRs
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
@@ -32,7 +33,11 @@ func getAllIvrConfigs(ctx context.Context, clientConfig *platformclientv2.Config
 	}
 
 	for _, entity := range *allIvrs {
-		resources[*entity.Id] = &resourceExporter.ResourceMeta{Name: *entity.Name}
+		resourceMeta := &resourceExporter.ResourceMeta{Name: *entity.Name}
+		if entity.Version != nil {
+			resourceMeta.Version = strconv.Itoa(*entity.Version)
+		}
+		resources[*entity.Id] = resourceMeta
 	}
 	return resources, nil
 }
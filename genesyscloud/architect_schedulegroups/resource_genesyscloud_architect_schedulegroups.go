@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
@@ -33,7 +34,11 @@ func getAllAuthArchitectSchedulegroups(ctx context.Context, clientConfig *platfo
 	}
 
 	for _, scheduleGroup := range *scheduleGroups {
-		resources[*scheduleGroup.Id] = &resourceExporter.ResourceMeta{Name: *scheduleGroup.Name}
+		resourceMeta := &resourceExporter.ResourceMeta{Name: *scheduleGroup.Name}
+		if scheduleGroup.Version != nil {
+			resourceMeta.Version = strconv.Itoa(*scheduleGroup.Version)
+		}
+		resources[*scheduleGroup.Id] = resourceMeta
 	}
 
 	return resources, nil
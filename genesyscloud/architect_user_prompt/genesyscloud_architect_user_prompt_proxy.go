@@ -3,13 +3,17 @@ package architect_user_prompt
 import (
 	"context"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *architectUserPromptProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[architectUserPromptProxy]
+
 type createArchitectUserPromptFunc func(ctx context.Context, p *architectUserPromptProxy, body platformclientv2.Prompt) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error)
 type getArchitectUserPromptFunc func(ctx context.Context, p *architectUserPromptProxy, id string, includeMediaUris bool, includeResources bool, language []string) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error, bool)
 type getAllArchitectUserPromptsFunc func(ctx context.Context, p *architectUserPromptProxy, includeMediaUris bool, includeResources bool, name string) (*[]platformclientv2.Prompt, *platformclientv2.APIResponse, error, bool)
@@ -50,11 +54,10 @@ func newArchitectUserPromptProxy(clientConfig *platformclientv2.Configuration) *
 }
 
 func getArchitectUserPromptProxy(clientConfig *platformclientv2.Configuration) *architectUserPromptProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectUserPromptProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newArchitectUserPromptProxy)
 }
 
 // createArchitectUserPrompt creates a new user prompt
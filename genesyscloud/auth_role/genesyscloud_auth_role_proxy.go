@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -12,9 +13,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *authRoleProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[authRoleProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createAuthRoleFunc func(ctx context.Context, p *authRoleProxy, domainOrganizationRole *platformclientv2.Domainorganizationrolecreate) (*platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
 type getAllAuthRoleFunc func(ctx context.Context, p *authRoleProxy) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
@@ -59,13 +63,13 @@ func newAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRolePro
 	}
 }
 
-// getAuthRoleProxy acts as a singleton to for the internalProxy.  It also ensures
+// getAuthRoleProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRoleProxy {
-	if internalProxy == nil {
-		internalProxy = newAuthRoleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newAuthRoleProxy)
 }
 
 // createAuthRole creates a Genesys Cloud auth role
@@ -3,6 +3,7 @@ package authorization_product
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -13,9 +14,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *authProductProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[authProductProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAuthorizationProductFunc func(ctx context.Context, p *authProductProxy, name string) (id string, retryable bool, response *platformclientv2.APIResponse, err error)
 
@@ -36,13 +40,13 @@ func newauthProductProxy(clientConfig *platformclientv2.Configuration) *authProd
 	}
 }
 
-// getauthProductProxy acts as a singleton to for the internalProxy.  It also ensures
+// getauthProductProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getauthProductProxy(clientConfig *platformclientv2.Configuration) *authProductProxy {
-	if internalProxy == nil {
-		internalProxy = newauthProductProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newauthProductProxy)
 }
 
 // getAuthorizationProduct returns a single Genesys Cloud authorization product by a name
@@ -26,40 +26,46 @@ func (p *DependentConsumerProxy) GetDependentConsumers(ctx context.Context, reso
 	return p.RetrieveDependentConsumersAttr(ctx, p, resourceKeys)
 }
 
-func (p *DependentConsumerProxy) GetAllWithPooledClient(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
-	return p.GetPooledClientAttr(method)
+func (p *DependentConsumerProxy) GetAllWithPooledClient(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+	return p.GetPooledClientAttr(ctx, method)
 }
 
 type retrieveDependentConsumersFunc func(ctx context.Context, p *DependentConsumerProxy, resourceKeys resourceExporter.ResourceInfo) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, error)
-type retrievePooledClientFunc func(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
+type retrievePooledClientFunc func(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
 
+// InternalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var InternalProxy *DependentConsumerProxy
 
-// GetDependentConsumerProxy acts as a singleton to for the InternalProxy.
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[DependentConsumerProxy]
+
+// GetDependentConsumerProxy returns the proxy of a client config, creating it on first use. The proxy of a nil client config
+// can only acquire a pooled client.
 func GetDependentConsumerProxy(ClientConfig *platformclientv2.Configuration) *DependentConsumerProxy {
-	return newDependentConsumerProxy(ClientConfig)
+	if InternalProxy != nil {
+		return InternalProxy
+	}
+	return proxyCache.Get(ClientConfig, newDependentConsumerProxy)
 }
 
 // newDependentConsumerProxy initializes the ruleset proxy with all of the data needed to communicate with Genesys Cloud
 func newDependentConsumerProxy(ClientConfig *platformclientv2.Configuration) *DependentConsumerProxy {
-	if InternalProxy == nil {
-		InternalProxy = &DependentConsumerProxy{
-			GetPooledClientAttr: retrievePooledClientFn,
-		}
+	proxy := &DependentConsumerProxy{
+		GetPooledClientAttr: retrievePooledClientFn,
 	}
 
 	if ClientConfig != nil {
 		api := platformclientv2.NewArchitectApiWithConfig(ClientConfig)
-		InternalProxy.ClientConfig = ClientConfig
-		InternalProxy.ArchitectApi = api
-		InternalProxy.RetrieveDependentConsumersAttr = retrieveDependentConsumersFn
+		proxy.ClientConfig = ClientConfig
+		proxy.ArchitectApi = api
+		proxy.RetrieveDependentConsumersAttr = retrieveDependentConsumersFn
 	}
-	return InternalProxy
+	return proxy
 }
 
-func retrievePooledClientFn(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+func retrievePooledClientFn(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
 	resourceFunc := provider.GetAllWithPooledClientCustom(method)
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	resources, dependsMap, err := resourceFunc(ctx)
 	if err != nil {
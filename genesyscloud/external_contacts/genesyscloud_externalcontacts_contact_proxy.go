@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -29,9 +30,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *externalContactsContactsProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[externalContactsContactsProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllExternalContactsFunc func(ctx context.Context, p *externalContactsContactsProxy) (*[]platformclientv2.Externalcontact, *platformclientv2.APIResponse, error)
 type createExternalContactFunc func(ctx context.Context, p *externalContactsContactsProxy, externalContact *platformclientv2.Externalcontact) (*platformclientv2.Externalcontact, *platformclientv2.APIResponse, error)
@@ -67,13 +71,13 @@ func newExternalContactsContactsProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getExternalContactsContactsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getExternalContactsContactsProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getExternalContactsContactsProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactsProxy {
-	if internalProxy == nil {
-		internalProxy = newExternalContactsContactsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newExternalContactsContactsProxy)
 }
 
 // getAllExternalContacts retrieves all Genesys Cloud External Contacts
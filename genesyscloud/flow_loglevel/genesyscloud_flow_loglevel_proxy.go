@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -27,9 +28,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *flowLogLevelProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[flowLogLevelProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createFlowLogLevelFunc func(ctx context.Context, p *flowLogLevelProxy, flowId string, flowLogLevelRequest *platformclientv2.Flowloglevelrequest) (*platformclientv2.Flowsettingsresponse, *platformclientv2.APIResponse, error)
 type getAllFlowLogLevelsFunc func(ctx context.Context, p *flowLogLevelProxy) (*[]platformclientv2.Flowsettingsresponse, *platformclientv2.APIResponse, error)
@@ -62,14 +66,13 @@ func newFlowLogLevelProxy(clientConfig *platformclientv2.Configuration) *flowLog
 	}
 }
 
-// getFlowLogLevelProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowLogLevelProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowLogLevelProxy(clientConfig *platformclientv2.Configuration) *flowLogLevelProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowLogLevelProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newFlowLogLevelProxy)
 }
 
 // getAllFlowLogLevels retrieves all Genesys Cloud Flow Log Levels
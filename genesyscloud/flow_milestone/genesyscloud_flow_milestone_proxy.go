@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -14,9 +15,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *flowMilestoneProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[flowMilestoneProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createFlowMilestoneFunc func(ctx context.Context, p *flowMilestoneProxy, flowMilestone *platformclientv2.Flowmilestone) (*platformclientv2.Flowmilestone, *platformclientv2.APIResponse, error)
 type getAllFlowMilestoneFunc func(ctx context.Context, p *flowMilestoneProxy) (*[]platformclientv2.Flowmilestone, *platformclientv2.APIResponse, error)
@@ -52,13 +56,13 @@ func newFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMi
 	}
 }
 
-// getFlowMilestoneProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowMilestoneProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMilestoneProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowMilestoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newFlowMilestoneProxy)
 }
 
 // createFlowMilestone creates a Genesys Cloud flow milestone
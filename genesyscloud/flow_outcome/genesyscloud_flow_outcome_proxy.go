@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -14,9 +15,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *flowOutcomeProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[flowOutcomeProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createFlowOutcomeFunc func(ctx context.Context, p *flowOutcomeProxy, flowOutcome *platformclientv2.Flowoutcome) (*platformclientv2.Flowoutcome, *platformclientv2.APIResponse, error)
 type getAllFlowOutcomeFunc func(ctx context.Context, p *flowOutcomeProxy) (*[]platformclientv2.Flowoutcome, *platformclientv2.APIResponse, error)
@@ -49,13 +53,13 @@ func newFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutc
 	}
 }
 
-// getFlowOutcomeProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowOutcomeProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutcomeProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowOutcomeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newFlowOutcomeProxy)
 }
 
 // createFlowOutcome creates a Genesys Cloud flow outcome
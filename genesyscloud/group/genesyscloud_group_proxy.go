@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 )
 
 var internalProxy *groupProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[groupProxy]
+
 type createGroupFunc func(ctx context.Context, p *groupProxy, group *platformclientv2.Groupcreate) (*platformclientv2.Group, *platformclientv2.APIResponse, error)
 type getAllGroupFunc func(ctx context.Context, p *groupProxy) (*[]platformclientv2.Group, *platformclientv2.APIResponse, error)
 type updateGroupFunc func(ctx context.Context, p *groupProxy, id string, group *platformclientv2.Groupupdate) (*platformclientv2.Group, *platformclientv2.APIResponse, error)
@@ -36,7 +40,7 @@ type groupProxy struct {
 
 func newGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
 	api := platformclientv2.NewGroupsApiWithConfig(clientConfig)
-	groupCache := rc.NewResourceCache[platformclientv2.Group]()
+	groupCache := rc.GetOrgResourceCache[platformclientv2.Group](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_group")
 	return &groupProxy{
 		clientConfig:           clientConfig,
 		groupsApi:              api,
@@ -54,11 +58,10 @@ func newGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
 }
 
 func getGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
-	if internalProxy == nil {
-		internalProxy = newGroupProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newGroupProxy)
 }
 
 func (p *groupProxy) createGroup(ctx context.Context, group *platformclientv2.Groupcreate) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
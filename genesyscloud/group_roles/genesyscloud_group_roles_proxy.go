@@ -3,6 +3,7 @@ package group_roles
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,6 +13,9 @@ import (
 
 var internalProxy *groupRolesProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[groupRolesProxy]
+
 type getGroupRolesByIdFunc func(ctx context.Context, p *groupRolesProxy, roleId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error)
 type updateGroupRolesFunc func(ctx context.Context, p *groupRolesProxy, roleId string, rolesConfig *schema.Set, subjectType string) (*platformclientv2.APIResponse, error)
 
@@ -33,10 +37,10 @@ func newGroupRolesProxy(clientConfig *platformclientv2.Configuration) *groupRole
 }
 
 func getGroupRolesProxy(clientConfig *platformclientv2.Configuration) *groupRolesProxy {
-	if internalProxy == nil {
-		internalProxy = newGroupRolesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newGroupRolesProxy)
 }
 
 func (p *groupRolesProxy) getGroupRolesById(ctx context.Context, roleId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
@@ -71,7 +75,7 @@ func updateGroupRolesFn(_ context.Context, p *groupRolesProxy, roleId string, ro
 	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(roleId, true)
 	grants, resp, err := getAssignedGrants(*subject.Id, p)
 
-	existingGrants, configGrants, _ := getExistingAndConfigGrants(grants, rolesConfig)
+	existingGrants, configGrants, _ := getExistingAndConfigGrants(p, grants, rolesConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to get current grants for subject %s: %s", roleId, err)
 	}
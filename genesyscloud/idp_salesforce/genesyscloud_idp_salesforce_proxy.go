@@ -3,6 +3,7 @@ package idp_salesforce
 import (
 	"context"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -11,9 +12,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *idpSalesforceProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[idpSalesforceProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getIdpSalesforceFunc func(ctx context.Context, p *idpSalesforceProxy) (salesforce *platformclientv2.Salesforce, resp *platformclientv2.APIResponse, err error)
 type updateIdpSalesforceFunc func(ctx context.Context, p *idpSalesforceProxy, salesforce *platformclientv2.Salesforce) (*platformclientv2.Identityprovider, *platformclientv2.APIResponse, error)
@@ -40,14 +44,13 @@ func newIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSal
 	}
 }
 
-// getIdpSalesforceProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpSalesforceProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSalesforceProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpSalesforceProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newIdpSalesforceProxy)
 }
 
 // getIdpSalesforce returns a single Genesys Cloud idp salesforce
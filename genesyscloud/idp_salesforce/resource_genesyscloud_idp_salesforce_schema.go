@@ -73,5 +73,6 @@ func IdpSalesforceExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllIdpSalesforce),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		SecretAttributes: []string{"certificates"},
 	}
 }
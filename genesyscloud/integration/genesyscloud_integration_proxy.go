@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -28,9 +29,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *integrationsProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[integrationsProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllIntegrationsFunc func(ctx context.Context, p *integrationsProxy) (*[]platformclientv2.Integration, *platformclientv2.APIResponse, error)
 type createIntegrationFunc func(ctx context.Context, p *integrationsProxy, integration *platformclientv2.Createintegrationrequest) (*platformclientv2.Integration, *platformclientv2.APIResponse, error)
@@ -72,13 +76,13 @@ func newIntegrationsProxy(clientConfig *platformclientv2.Configuration) *integra
 	}
 }
 
-// getIntegrationsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIntegrationsProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIntegrationsProxy(clientConfig *platformclientv2.Configuration) *integrationsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newIntegrationsProxy)
 }
 
 // getAllIntegrations retrieves all Genesys Cloud Integrations
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -39,9 +40,12 @@ the 'Items' property to define the 'object' schema it allows. Since it's impossi
 helper methods and types are created to invoke the APIs with Genesys Cloud.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *integrationActionsProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[integrationActionsProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllIntegrationActionsFunc func(ctx context.Context, p *integrationActionsProxy) (*[]platformclientv2.Action, *platformclientv2.APIResponse, error)
 type createIntegrationActionFunc func(ctx context.Context, p *integrationActionsProxy, action *IntegrationAction) (*IntegrationAction, *platformclientv2.APIResponse, error)
@@ -80,13 +84,13 @@ func newIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *i
 	}
 }
 
-// getIntegrationActionsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIntegrationActionsProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *integrationActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newIntegrationActionsProxy)
 }
 
 // getAllIntegrationActions retrieves all Genesys Cloud Integration Actions
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -28,9 +29,12 @@ Each proxy implementation:
 7.  Function implementations for each function type definition.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *customAuthActionsProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[customAuthActionsProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllIntegrationCustomAuthActionsFunc func(ctx context.Context, p *customAuthActionsProxy) (*[]platformclientv2.Action, *platformclientv2.APIResponse, error)
 type getCustomAuthActionByIdFunc func(ctx context.Context, p *customAuthActionsProxy, actionId string) (*platformclientv2.Action, *platformclientv2.APIResponse, error)
@@ -69,13 +73,13 @@ func newCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *cu
 	}
 }
 
-// getCustomAuthActionsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getCustomAuthActionsProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *customAuthActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newCustomAuthActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newCustomAuthActionsProxy)
 }
 
 // getAllIntegrationCustomAuthActions retrieves all Genesys Cloud Integration Custom Auth Actions
@@ -52,7 +52,7 @@ func createOAuthClient(ctx context.Context, d *schema.ResourceData, meta interfa
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	oauthClientProxy := GetOAuthClientProxy(sdkConfig)
 
-	roles, diagErr := buildOAuthRoles(d)
+	roles, diagErr := buildOAuthRoles(d, sdkConfig)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -218,7 +218,7 @@ func updateOAuthClient(ctx context.Context, d *schema.ResourceData, meta interfa
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	oauthClientProxy := GetOAuthClientProxy(sdkConfig)
 
-	roles, diagErr := buildOAuthRoles(d)
+	roles, diagErr := buildOAuthRoles(d, sdkConfig)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"log"
 	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
 var internalProxy *oauthClientProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[oauthClientProxy]
+
 type createOAuthClientFunc func(context.Context, *oauthClientProxy, platformclientv2.Oauthclientrequest) (*platformclientv2.Oauthclient, *platformclientv2.APIResponse, error)
 type createIntegrationClientFunc func(context.Context, *oauthClientProxy, platformclientv2.Credential) (*platformclientv2.Credentialinfo, *platformclientv2.APIResponse, error)
 type updateOAuthClientFunc func(context.Context, *oauthClientProxy, string, platformclientv2.Oauthclientrequest) (*platformclientv2.Oauthclient, *platformclientv2.APIResponse, error)
@@ -90,10 +94,10 @@ into the integration credential object.  Today the integration credential object
 without because once the oauth client is created, we dont want to expose the secret.
 */
 func GetOAuthClientProxy(clientConfig *platformclientv2.Configuration) *oauthClientProxy {
-	if internalProxy == nil {
-		internalProxy = newOAuthClientProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOAuthClientProxy)
 }
 
 func (o *oauthClientProxy) deleteOAuthClient(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
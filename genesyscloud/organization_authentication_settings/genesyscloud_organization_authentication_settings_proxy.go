@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -12,9 +13,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *orgAuthSettingsProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[orgAuthSettingsProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getOrgAuthSettingsByIdFunc func(ctx context.Context, p *orgAuthSettingsProxy, id string) (orgAuthSettings *platformclientv2.Orgauthsettings, response *platformclientv2.APIResponse, err error)
 type updateOrgAuthSettingsFunc func(ctx context.Context, p *orgAuthSettingsProxy, orgAuthSettings *platformclientv2.Orgauthsettings) (*platformclientv2.Orgauthsettings, *platformclientv2.APIResponse, error)
@@ -38,13 +42,13 @@ func newOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgA
 	}
 }
 
-// getOrgAuthSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOrgAuthSettingsProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgAuthSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOrgAuthSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOrgAuthSettingsProxy)
 }
 
 // getOrgAuthSettingsById returns a single Genesys Cloud organization authentication settings by Id
@@ -3,12 +3,16 @@ package orgauthorization_pairing
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
 var internalProxy *orgauthorizationPairingProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[orgauthorizationPairingProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOrgauthorizationPairingFunc func(ctx context.Context, p *orgauthorizationPairingProxy, trustRequestCreate *platformclientv2.Trustrequestcreate) (*platformclientv2.Trustrequest, *platformclientv2.APIResponse, error)
 type getOrgauthorizationPairingByIdFunc func(ctx context.Context, p *orgauthorizationPairingProxy, id string) (trustRequest *platformclientv2.Trustrequest, response *platformclientv2.APIResponse, err error)
@@ -32,13 +36,13 @@ func newOrgauthorizationPairingProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getOrgauthorizationPairingProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOrgauthorizationPairingProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOrgauthorizationPairingProxy(clientConfig *platformclientv2.Configuration) *orgauthorizationPairingProxy {
-	if internalProxy == nil {
-		internalProxy = newOrgauthorizationPairingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOrgauthorizationPairingProxy)
 }
 
 // createOrgauthorizationPairing creates a Genesys Cloud orgauthorization pairing
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -14,9 +15,12 @@ The genesyscloud_outbound_callabletimeset_proxy.go file contains the proxy struc
 with the Genesys Cloud SDK
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *outboundCallableTimesetProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[outboundCallableTimesetProxy]
+
 // type definitions for each func on our proxy
 type createOutboundCallabletimesetFunc func(ctx context.Context, p *outboundCallableTimesetProxy, timeset *platformclientv2.Callabletimeset) (*platformclientv2.Callabletimeset, *platformclientv2.APIResponse, error)
 type getAllOutboundCallableTimesetFunc func(ctx context.Context, p *outboundCallableTimesetProxy) (*[]platformclientv2.Callabletimeset, *platformclientv2.APIResponse, error)
@@ -53,11 +57,10 @@ func newOutboundCallableTimesetProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getOutboundCallabletimesetProxy(clientConfig *platformclientv2.Configuration) *outboundCallableTimesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCallableTimesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOutboundCallableTimesetProxy)
 }
 
 // createOutboundCallabletimeset creates a Genesys Cloud Outbound Callable Timeset
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -13,9 +14,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *outboundCallanalysisresponsesetProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[outboundCallanalysisresponsesetProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCallanalysisresponsesetFunc func(ctx context.Context, p *outboundCallanalysisresponsesetProxy, responseSet *platformclientv2.Responseset) (*platformclientv2.Responseset, *platformclientv2.APIResponse, error)
 type getAllOutboundCallanalysisresponsesetFunc func(ctx context.Context, p *outboundCallanalysisresponsesetProxy, name string) (*[]platformclientv2.Responseset, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newOutboundCallanalysisresponsesetProxy(clientConfig *platformclientv2.Conf
 	}
 }
 
-// getOutboundCallanalysisresponsesetProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCallanalysisresponsesetProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCallanalysisresponsesetProxy(clientConfig *platformclientv2.Configuration) *outboundCallanalysisresponsesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCallanalysisresponsesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOutboundCallanalysisresponsesetProxy)
 }
 
 // createOutboundCallanalysisresponseset creates a Genesys Cloud outbound callanalysisresponseset
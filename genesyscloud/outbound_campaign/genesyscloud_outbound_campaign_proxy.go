@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"time"
@@ -20,9 +21,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *outboundCampaignProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[outboundCampaignProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error)
 type getAllOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy) (*[]platformclientv2.Campaign, *platformclientv2.APIResponse, error)
@@ -47,7 +51,7 @@ type outboundCampaignProxy struct {
 // newOutboundCampaignProxy initializes the outbound campaign proxy with all of the data needed to communicate with Genesys Cloud
 func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignProxy {
 	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
-	campaignCache := rc.NewResourceCache[platformclientv2.Campaign]()
+	campaignCache := rc.GetOrgResourceCache[platformclientv2.Campaign](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_outbound_campaign")
 	return &outboundCampaignProxy{
 		clientConfig:                    clientConfig,
 		outboundApi:                     api,
@@ -61,13 +65,13 @@ func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *out
 	}
 }
 
-// getOutboundCampaignProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCampaignProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCampaignProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOutboundCampaignProxy)
 }
 
 // createOutboundCampaign creates a Genesys Cloud outbound campaign
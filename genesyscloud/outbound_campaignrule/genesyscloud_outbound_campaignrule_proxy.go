@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -14,9 +15,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *outboundCampaignruleProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[outboundCampaignruleProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCampaignruleFunc func(ctx context.Context, p *outboundCampaignruleProxy, campaignRule *platformclientv2.Campaignrule) (*platformclientv2.Campaignrule, *platformclientv2.APIResponse, error)
 type getAllOutboundCampaignruleFunc func(ctx context.Context, p *outboundCampaignruleProxy) (*[]platformclientv2.Campaignrule, *platformclientv2.APIResponse, error)
@@ -52,13 +56,13 @@ func newOutboundCampaignruleProxy(clientConfig *platformclientv2.Configuration)
 	}
 }
 
-// getOutboundCampaignruleProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCampaignruleProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCampaignruleProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignruleProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCampaignruleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOutboundCampaignruleProxy)
 }
 
 // createOutboundCampaignrule creates a Genesys Cloud outbound campaignrule
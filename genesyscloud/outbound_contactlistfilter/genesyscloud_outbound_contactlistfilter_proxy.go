@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -13,9 +14,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *outboundContactlistfilterProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[outboundContactlistfilterProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundContactlistfilterFunc func(ctx context.Context, p *outboundContactlistfilterProxy, contactListFilter *platformclientv2.Contactlistfilter) (*platformclientv2.Contactlistfilter, *platformclientv2.APIResponse, error)
 type getAllOutboundContactlistfilterFunc func(ctx context.Context, p *outboundContactlistfilterProxy, name string) (*[]platformclientv2.Contactlistfilter, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newOutboundContactlistfilterProxy(clientConfig *platformclientv2.Configurat
 	}
 }
 
-// getOutboundContactlistfilterProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundContactlistfilterProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundContactlistfilterProxy(clientConfig *platformclientv2.Configuration) *outboundContactlistfilterProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundContactlistfilterProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOutboundContactlistfilterProxy)
 }
 
 // createOutboundContactlistfilter creates a Genesys Cloud outbound contactlistfilter
@@ -6,11 +6,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 )
 
 var internalProxy *outboundDnclistProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[outboundDnclistProxy]
+
 // type definitions for each func on our proxy
 type createOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy, dnclist *platformclientv2.Dnclistcreate) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error)
 type getAllOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy) (*[]platformclientv2.Dnclist, *platformclientv2.APIResponse, error)
@@ -50,10 +54,10 @@ func newOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outb
 }
 
 func getOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outboundDnclistProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundDnclistProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOutboundDnclistProxy)
 }
 
 // createOutboundDnclist creates a Genesys Cloud Outbound Dnclist
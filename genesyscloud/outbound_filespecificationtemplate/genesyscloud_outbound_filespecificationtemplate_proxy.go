@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -14,9 +15,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *outboundFilespecificationtemplateProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[outboundFilespecificationtemplateProxy]
+
 // Type definitions for each func on our proxy, so we can easily mock them out later
 type createOutboundFilespecificationtemplateFunc func(ctx context.Context, p *outboundFilespecificationtemplateProxy, fileSpecificationTemplate *platformclientv2.Filespecificationtemplate) (*platformclientv2.Filespecificationtemplate, *platformclientv2.APIResponse, error)
 type getAllOutboundFilespecificationtemplateFunc func(ctx context.Context, p *outboundFilespecificationtemplateProxy, name string) (*[]platformclientv2.Filespecificationtemplate, *platformclientv2.APIResponse, error)
@@ -53,14 +57,13 @@ func newOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Co
 	}
 }
 
-// getOutboundFilespecificationtemplateProxy acts as a singleton to for the internalProxy. It also ensures
+// getOutboundFilespecificationtemplateProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Configuration) *outboundFilespecificationtemplateProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundFilespecificationtemplateProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newOutboundFilespecificationtemplateProxy)
 }
 
 // createOutboundFilespecificationtemplate creates a Genesys Cloud outbound filespecificationtemplate
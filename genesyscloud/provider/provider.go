@@ -83,6 +83,42 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 					Description:  "AWS region where org exists. e.g. us-east-1. Can be set with the `GENESYSCLOUD_REGION` environment variable.",
 					ValidateFunc: validation.StringInSlice(getAllowedRegions(), true),
 				},
+				"api_base_url": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc("GENESYSCLOUD_API_BASE_URL", nil),
+					Description:   "Base URL of the Genesys Cloud API, e.g. https://api.mypurecloud.com. Overrides `aws_region` for regions and environments the provider does not know of, or a local stand-in server. Can be set with the `GENESYSCLOUD_API_BASE_URL` environment variable.",
+					ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+					ConflictsWith: []string{"login_domain"},
+				},
+				"auth_base_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_AUTH_BASE_URL", nil),
+					Description:  "Base URL of the Genesys Cloud login service that issues access tokens, e.g. https://login.mypurecloud.com. Defaults to the login URL of the API base URL. Can be set with the `GENESYSCLOUD_AUTH_BASE_URL` environment variable.",
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+				"login_domain": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc("GENESYSCLOUD_LOGIN_DOMAIN", nil),
+					Description:   "Login domain of the org, e.g. login.mypurecloud.de. The API and login base URLs are derived from the domain instead of `aws_region`. Can be set with the `GENESYSCLOUD_LOGIN_DOMAIN` environment variable.",
+					ConflictsWith: []string{"api_base_url"},
+				},
+				"max_requests_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_MAX_REQUESTS_PER_SECOND", 0),
+					Description:  "Max number of API requests per second sent by the clients of the token pool. The rate is lowered when the API throttles requests and raised back up to this limit while requests succeed. 0 only limits the rate once requests are throttled. Can be set with the `GENESYSCLOUD_MAX_REQUESTS_PER_SECOND` environment variable.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_MAX_CONCURRENT_REQUESTS", 0),
+					Description:  "Max number of resource operations run at the same time across the clients of the token pool. 0 allows one per client. Can be set with the `GENESYSCLOUD_MAX_CONCURRENT_REQUESTS` environment variable.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
 				"sdk_debug": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -158,6 +194,57 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 						},
 					},
 				},
+				"auth": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Authenticates the provider with a grant flow other than client credentials. When set, `oauthclient_secret` is only sent if it is configured. Ignored when `access_token` is set.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"method": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The grant flow used to get an access token. `jwt_bearer` exchanges a JWT, such as a GitHub Actions or GitLab OIDC token, for an access token. `saml2_bearer` exchanges a SAML2 assertion. `token_file` reads an access token from a file and `token_command` reads it from the output of a command.",
+								ValidateFunc: validation.StringInSlice([]string{authMethodJwtBearer, authMethodSaml2Bearer, authMethodTokenFile, authMethodTokenCommand}, false),
+							},
+							"assertion": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_AUTH_ASSERTION", nil),
+								Description: "The JWT or base64 encoded SAML2 assertion exchanged by the `jwt_bearer` and `saml2_bearer` methods. Can be set with the `GENESYSCLOUD_AUTH_ASSERTION` environment variable.",
+							},
+							"assertion_file": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "A file containing the assertion exchanged by the `jwt_bearer` and `saml2_bearer` methods. The file is read again on every token refresh, so it can hold a workload identity token that is rotated.",
+							},
+							"org_name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The name of the org. Required by the `saml2_bearer` method.",
+							},
+							"token_file": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The file the `token_file` method reads the access token from. The file can contain the token or a JSON object with `access_token` and `expires_in` fields.",
+							},
+							"token_command": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The program and arguments of the command the `token_command` method runs. The command must write the token, or a JSON object with `access_token` and `expires_in` fields, to stdout.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"token_refresh_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      300,
+								Description:  "How often a token read by the `token_file` or `token_command` method is read again when its expiry is unknown.",
+								ValidateFunc: validation.IntAtLeast(30),
+							},
+						},
+					},
+				},
 			},
 			ResourcesMap:         copiedResources,
 			DataSourcesMap:       copiedDataSources,
@@ -169,34 +256,28 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 type ProviderMeta struct {
 	Version      string
 	ClientConfig *platformclientv2.Configuration
+	ClientPool   *SDKClientPool
 	Domain       string
 }
 
 func configure(version string) schema.ConfigureContextFunc {
 	return func(context context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		// Initialize a single client if we have an access token
-		accessToken := data.Get("access_token").(string)
-		if accessToken != "" {
-			Once.Do(func() {
-				sdkConfig := platformclientv2.GetDefaultConfiguration()
-				_ = InitClientConfig(data, version, sdkConfig)
-
-				SdkClientPool = &SDKClientPool{
-					Pool: make(chan *platformclientv2.Configuration, 1),
-				}
-				SdkClientPool.Pool <- sdkConfig
-			})
-		} else {
-			// Initialize the SDK Client pool
-			err := InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
-			if err != nil {
-				return nil, err
-			}
+		poolSize := data.Get("token_pool_size").(int)
+		if data.Get("access_token").(string) != "" || authMethodSharesToken(data) {
+			poolSize = 1
+		}
+
+		// Initialize the SDK Client pool of this provider instance
+		pool, err := InitSDKClientPool(poolSize, version, data)
+		if err != nil {
+			return nil, err
 		}
 		return &ProviderMeta{
 			Version:      version,
-			ClientConfig: platformclientv2.GetDefaultConfiguration(),
-			Domain:       getRegionDomain(data.Get("aws_region").(string)),
+			ClientConfig: pool.defaultConfig(),
+			ClientPool:   pool,
+			Domain:       getAPIDomain(pool.defaultConfig().BasePath),
 		}, nil
 	}
 }
@@ -244,8 +325,8 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 	accessToken := data.Get("access_token").(string)
 	oauthclientID := data.Get("oauthclient_id").(string)
 	oauthclientSecret := data.Get("oauthclient_secret").(string)
-	basePath := GetRegionBasePath(data.Get("aws_region").(string))
-	config.BasePath = basePath
+	apiBaseURL, authBaseURL := getEndpoints(data)
+	config.BasePath = apiBaseURL
 
 	diagErr := setUpSDKLogging(data, config)
 	if diagErr != nil {
@@ -270,9 +351,32 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 		},
 	}
 
+	auth, diagErr := getAuthConfig(data)
+	if diagErr != nil {
+		return diagErr
+	}
+
 	if accessToken != "" {
 		log.Print("Setting access token set on configuration instance.")
 		config.AccessToken = accessToken
+	} else if auth != nil {
+		log.Printf("Authorizing configuration instance with the %s auth method.", auth.method)
+		diagErr = authorizeClientConfig(config, auth)
+		if diagErr != nil {
+			return diagErr
+		}
+	} else if authBaseURL != getDefaultAuthBaseURL(apiBaseURL) {
+		// The SDK can only request tokens from the login URL of the base path
+		log.Printf("Authorizing configuration instance with client credentials from %s.", authBaseURL)
+		diagErr = authorizeClientConfig(config, &authConfig{
+			method:       authMethodClientCredentials,
+			clientID:     oauthclientID,
+			clientSecret: oauthclientSecret,
+			authBaseURL:  authBaseURL,
+		})
+		if diagErr != nil {
+			return diagErr
+		}
 	} else {
 		config.AutomaticTokenRefresh = true // Enable automatic token refreshing
 
@@ -370,6 +474,9 @@ func AuthorizeSdk() (*platformclientv2.Configuration, error) {
 	}
 
 	sdkConfig.BasePath = GetRegionBasePath(os.Getenv("GENESYSCLOUD_REGION"))
+	if apiBaseURL := os.Getenv("GENESYSCLOUD_API_BASE_URL"); apiBaseURL != "" {
+		sdkConfig.BasePath = strings.TrimSuffix(apiBaseURL, "/")
+	}
 
 	diagErr := withRetries(context.Background(), time.Minute, func() *retry.RetryError {
 		err := sdkConfig.AuthorizeClientCredentials(os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"), os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"))
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+)
+
+/*
+This file contains the authentication methods of the auth block of the provider. Each method gets an access token for a client
+config without a long-lived client secret. The token of a pooled client is refreshed when the client is acquired from the pool
+shortly before the token expires, so resources never see an expired token.
+*/
+
+const (
+	authMethodJwtBearer    = "jwt_bearer"
+	authMethodSaml2Bearer  = "saml2_bearer"
+	authMethodTokenFile    = "token_file"
+	authMethodTokenCommand = "token_command"
+
+	// Used when the login URL is not the one the SDK derives from the base path
+	authMethodClientCredentials = "client_credentials"
+
+	grantTypeJwtBearer   = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	grantTypeSaml2Bearer = "urn:ietf:params:oauth:grant-type:saml2-bearer"
+
+	// Tokens are refreshed this long before they expire
+	tokenRefreshMargin = time.Minute
+
+	tokenCommandTimeout = time.Minute
+)
+
+type authConfig struct {
+	method          string
+	clientID        string
+	clientSecret    string
+	assertion       string
+	assertionFile   string
+	orgName         string
+	tokenFile       string
+	tokenCommand    []string
+	refreshInterval time.Duration
+	authBaseURL     string
+}
+
+// authToken tracks when the access token of a client config authorized by an auth method must be refreshed
+type authToken struct {
+	lock      sync.Mutex
+	auth      *authConfig
+	refreshAt time.Time
+}
+
+// The auth tokens of the client configs authorized by an auth method
+var authTokens sync.Map
+
+// getAuthConfig reads the auth block of the provider config. It returns nil if the block is not set.
+func getAuthConfig(data *schema.ResourceData) (*authConfig, diag.Diagnostics) {
+	authSet, ok := data.Get("auth").(*schema.Set)
+	if !ok || authSet.Len() == 0 {
+		return nil, nil
+	}
+	authMap := authSet.List()[0].(map[string]interface{})
+	_, authBaseURL := getEndpoints(data)
+
+	auth := &authConfig{
+		method:          authMap["method"].(string),
+		clientID:        data.Get("oauthclient_id").(string),
+		clientSecret:    data.Get("oauthclient_secret").(string),
+		assertion:       authMap["assertion"].(string),
+		assertionFile:   authMap["assertion_file"].(string),
+		orgName:         authMap["org_name"].(string),
+		tokenFile:       authMap["token_file"].(string),
+		refreshInterval: time.Duration(authMap["token_refresh_seconds"].(int)) * time.Second,
+		authBaseURL:     authBaseURL,
+	}
+	for _, arg := range authMap["token_command"].([]interface{}) {
+		auth.tokenCommand = append(auth.tokenCommand, arg.(string))
+	}
+
+	switch auth.method {
+	case authMethodJwtBearer, authMethodSaml2Bearer:
+		if auth.assertion == "" && auth.assertionFile == "" {
+			return nil, diag.Errorf("the %s auth method requires assertion or assertion_file", auth.method)
+		}
+		if auth.clientID == "" {
+			return nil, diag.Errorf("the %s auth method requires oauthclient_id", auth.method)
+		}
+		if auth.method == authMethodSaml2Bearer && auth.orgName == "" {
+			return nil, diag.Errorf("the %s auth method requires org_name", auth.method)
+		}
+	case authMethodTokenFile:
+		if auth.tokenFile == "" {
+			return nil, diag.Errorf("the %s auth method requires token_file", auth.method)
+		}
+	case authMethodTokenCommand:
+		if len(auth.tokenCommand) == 0 || auth.tokenCommand[0] == "" {
+			return nil, diag.Errorf("the %s auth method requires token_command", auth.method)
+		}
+	}
+	return auth, nil
+}
+
+// authMethodSharesToken returns true if every client of the pool would get the same access token from the auth method of a
+// provider config. A larger pool does not increase the rate limits of such a token.
+func authMethodSharesToken(data *schema.ResourceData) bool {
+	auth, _ := getAuthConfig(data)
+	return auth != nil && (auth.method == authMethodTokenFile || auth.method == authMethodTokenCommand)
+}
+
+// authorizeClientConfig gets the first access token of a client config and registers it to be refreshed
+func authorizeClientConfig(config *platformclientv2.Configuration, auth *authConfig) diag.Diagnostics {
+	token := &authToken{auth: auth}
+	if err := token.refresh(config); err != nil {
+		return diag.Errorf("failed to authorize Genesys Cloud client with the %s auth method: %v", auth.method, err)
+	}
+	authTokens.Store(config, token)
+	return nil
+}
+
+// refreshAccessToken refreshes the access token of a client config authorized by an auth method if it is about to expire
+func refreshAccessToken(config *platformclientv2.Configuration) error {
+	value, ok := authTokens.Load(config)
+	if !ok {
+		return nil
+	}
+
+	token := value.(*authToken)
+	token.lock.Lock()
+	defer token.lock.Unlock()
+	if time.Now().Before(token.refreshAt) {
+		return nil
+	}
+	log.Printf("Refreshing access token with the %s auth method", token.auth.method)
+	return token.refresh(config)
+}
+
+func (t *authToken) refresh(config *platformclientv2.Configuration) error {
+	accessToken, expiresIn, err := t.auth.getAccessToken(config)
+	if err != nil {
+		return err
+	}
+	config.AccessToken = accessToken
+
+	switch {
+	case expiresIn <= 0:
+		t.refreshAt = time.Now().Add(t.auth.refreshInterval)
+	case expiresIn > 2*tokenRefreshMargin:
+		t.refreshAt = time.Now().Add(expiresIn - tokenRefreshMargin)
+	default:
+		t.refreshAt = time.Now().Add(expiresIn / 2)
+	}
+	return nil
+}
+
+// getAccessToken returns a new access token and how long it is valid for. A duration of 0 means the expiry is unknown.
+func (a *authConfig) getAccessToken(config *platformclientv2.Configuration) (string, time.Duration, error) {
+	switch a.method {
+	case authMethodJwtBearer, authMethodSaml2Bearer:
+		return a.exchangeAssertion(config)
+	case authMethodClientCredentials:
+		formParams := url.Values{}
+		formParams.Set("grant_type", "client_credentials")
+		return a.requestToken(config, formParams)
+	case authMethodTokenFile:
+		data, err := os.ReadFile(a.tokenFile)
+		if err != nil {
+			return "", 0, err
+		}
+		return parseAccessToken(data)
+	case authMethodTokenCommand:
+		return a.runTokenCommand()
+	}
+	return "", 0, fmt.Errorf("unknown auth method %s", a.method)
+}
+
+// exchangeAssertion exchanges a JWT or SAML2 assertion for an access token at the token endpoint of the login URL
+func (a *authConfig) exchangeAssertion(config *platformclientv2.Configuration) (string, time.Duration, error) {
+	assertion := a.assertion
+	if a.assertionFile != "" {
+		data, err := os.ReadFile(a.assertionFile)
+		if err != nil {
+			return "", 0, err
+		}
+		assertion = strings.TrimSpace(string(data))
+	}
+
+	formParams := url.Values{}
+	formParams.Set("assertion", assertion)
+	if a.method == authMethodSaml2Bearer {
+		formParams.Set("grant_type", grantTypeSaml2Bearer)
+		formParams.Set("orgName", a.orgName)
+	} else {
+		formParams.Set("grant_type", grantTypeJwtBearer)
+	}
+	return a.requestToken(config, formParams)
+}
+
+// requestToken requests an access token from the token endpoint of the login URL
+func (a *authConfig) requestToken(config *platformclientv2.Configuration, formParams url.Values) (string, time.Duration, error) {
+	// A client without a secret identifies itself in the request body
+	headerParams := make(map[string]string)
+	if a.clientSecret != "" {
+		headerParams["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(a.clientID+":"+a.clientSecret))
+	} else {
+		formParams.Set("client_id", a.clientID)
+	}
+
+	response, err := config.APIClient.CallAPI(a.authBaseURL+"/oauth/token", http.MethodPost, nil, headerParams, nil, formParams, "", nil)
+	if err != nil && response == nil {
+		return "", 0, err
+	}
+	if response.StatusCode != http.StatusOK {
+		var authErrorResponse platformclientv2.AuthErrorResponse
+		if err := json.Unmarshal(response.RawBody, &authErrorResponse); err != nil {
+			return "", 0, fmt.Errorf("auth error: %v", response.StatusCode)
+		}
+		return "", 0, fmt.Errorf("auth error: %v - %v (%v)", response.StatusCode, authErrorResponse.Error, authErrorResponse.ErrorDescription)
+	}
+	return parseAccessToken(response.RawBody)
+}
+
+func (a *authConfig) runTokenCommand() (string, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenCommandTimeout)
+	defer cancel()
+
+	var stderr strings.Builder
+	cmd := exec.CommandContext(ctx, a.tokenCommand[0], a.tokenCommand[1:]...)
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", 0, fmt.Errorf("token command %s failed: %v %s", a.tokenCommand[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return parseAccessToken(output)
+}
+
+// parseAccessToken reads a token response. The response is either a raw access token or a JSON object with the access_token and
+// expires_in fields of an OAuth token response.
+func parseAccessToken(data []byte) (string, time.Duration, error) {
+	content := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(content, "{") {
+		if content == "" {
+			return "", 0, fmt.Errorf("no access token found")
+		}
+		return content, 0, nil
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal([]byte(content), &tokenResponse); err != nil {
+		return "", 0, err
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", 0, fmt.Errorf("no access token found")
+	}
+	return tokenResponse.AccessToken, time.Duration(tokenResponse.ExpiresIn) * time.Second, nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitProviderAuthTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.Nil(t, os.WriteFile(tokenFile, []byte(`{"access_token": "token-1", "expires_in": 3600}`), 0600))
+
+	data := testAuthProviderConfig(t, "", map[string]interface{}{
+		"method":     authMethodTokenFile,
+		"token_file": tokenFile,
+	})
+	assert.True(t, authMethodSharesToken(data))
+	auth, diagErr := getAuthConfig(data)
+	assert.Nil(t, diagErr)
+
+	config := platformclientv2.NewConfiguration()
+	assert.Nil(t, authorizeClientConfig(config, auth))
+	assert.Equal(t, "token-1", config.AccessToken)
+
+	// The token is only read again shortly before it expires
+	assert.Nil(t, os.WriteFile(tokenFile, []byte("token-2\n"), 0600))
+	assert.Nil(t, refreshAccessToken(config))
+	assert.Equal(t, "token-1", config.AccessToken)
+
+	value, _ := authTokens.Load(config)
+	value.(*authToken).refreshAt = time.Now()
+	assert.Nil(t, refreshAccessToken(config))
+	assert.Equal(t, "token-2", config.AccessToken)
+}
+
+func TestUnitProviderAuthTokenCommand(t *testing.T) {
+	data := testAuthProviderConfig(t, "", map[string]interface{}{
+		"method":        authMethodTokenCommand,
+		"token_command": []interface{}{"echo", "command-token"},
+	})
+	auth, diagErr := getAuthConfig(data)
+	assert.Nil(t, diagErr)
+
+	config := platformclientv2.NewConfiguration()
+	assert.Nil(t, authorizeClientConfig(config, auth))
+	assert.Equal(t, "command-token", config.AccessToken)
+}
+
+func TestUnitProviderAuthJwtBearer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, grantTypeJwtBearer, r.PostForm.Get("grant_type"))
+		assert.Equal(t, "oidc-jwt", r.PostForm.Get("assertion"))
+		assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "exchanged-token", "token_type": "bearer", "expires_in": 86399}`))
+	}))
+	defer server.Close()
+
+	data := testAuthProviderConfig(t, server.URL, map[string]interface{}{
+		"method":    authMethodJwtBearer,
+		"assertion": "oidc-jwt",
+	})
+	assert.False(t, authMethodSharesToken(data))
+	auth, diagErr := getAuthConfig(data)
+	assert.Nil(t, diagErr)
+
+	config := platformclientv2.NewConfiguration()
+	assert.Nil(t, authorizeClientConfig(config, auth))
+	assert.Equal(t, "exchanged-token", config.AccessToken)
+
+	// The required settings of a method are validated
+	_, diagErr = getAuthConfig(testAuthProviderConfig(t, "", map[string]interface{}{"method": authMethodSaml2Bearer, "assertion": "saml"}))
+	assert.NotNil(t, diagErr)
+}
+
+func testAuthProviderConfig(t *testing.T, apiBaseURL string, auth map[string]interface{}) *schema.ResourceData {
+	providerSchema := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().Schema
+	return schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"aws_region":     "us-east-1",
+		"api_base_url":   apiBaseURL,
+		"oauthclient_id": "client-id",
+		"auth":           []interface{}{auth},
+	})
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+This file resolves the API and login base URLs of the provider. They are derived from the login domain or the API base URL of
+the provider config when set, so regions and environments that are not in the region map, or a local stand-in server, can be
+used without a provider release. Otherwise they are looked up from aws_region.
+*/
+
+var apiHostRegex = regexp.MustCompile(`(?i)//api\.`)
+
+// getEndpoints returns the API and login base URLs of a provider config
+func getEndpoints(data *schema.ResourceData) (apiBaseURL string, authBaseURL string) {
+	apiBaseURL = GetRegionBasePath(data.Get("aws_region").(string))
+	if loginDomain := data.Get("login_domain").(string); loginDomain != "" {
+		apiBaseURL = "https://api." + getLoginDomain(loginDomain)
+	} else if baseURL := data.Get("api_base_url").(string); baseURL != "" {
+		apiBaseURL = strings.TrimSuffix(baseURL, "/")
+	}
+
+	authBaseURL = getDefaultAuthBaseURL(apiBaseURL)
+	if baseURL := data.Get("auth_base_url").(string); baseURL != "" {
+		authBaseURL = strings.TrimSuffix(baseURL, "/")
+	}
+	return apiBaseURL, authBaseURL
+}
+
+// getLoginDomain returns the domain of a login domain or login URL, e.g. mypurecloud.de for https://login.mypurecloud.de
+func getLoginDomain(loginDomain string) string {
+	domain := strings.ToLower(strings.TrimSpace(loginDomain))
+	domain = strings.TrimPrefix(strings.TrimPrefix(domain, "https://"), "http://")
+	domain = strings.TrimSuffix(domain, "/")
+	return strings.TrimPrefix(domain, "login.")
+}
+
+// getDefaultAuthBaseURL returns the login base URL of an API base URL. The SDK requests client credentials tokens from this URL.
+func getDefaultAuthBaseURL(apiBaseURL string) string {
+	return apiHostRegex.ReplaceAllString(apiBaseURL, "//login.")
+}
+
+// getAPIDomain returns the domain of an API base URL, e.g. mypurecloud.com for https://api.mypurecloud.com
+func getAPIDomain(apiBaseURL string) string {
+	baseURL, err := url.Parse(apiBaseURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(baseURL.Hostname(), "api.")
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitProviderEndpoints(t *testing.T) {
+	endpoints := map[string]struct {
+		config      map[string]interface{}
+		apiBaseURL  string
+		authBaseURL string
+	}{
+		"region": {
+			config:      map[string]interface{}{"aws_region": "eu-central-1"},
+			apiBaseURL:  "https://api.mypurecloud.de",
+			authBaseURL: "https://login.mypurecloud.de",
+		},
+		"login domain": {
+			config:      map[string]interface{}{"aws_region": "us-east-1", "login_domain": "https://login.euw3.pure.cloud/"},
+			apiBaseURL:  "https://api.euw3.pure.cloud",
+			authBaseURL: "https://login.euw3.pure.cloud",
+		},
+		"base urls": {
+			config:      map[string]interface{}{"api_base_url": "https://gateway.example.com/", "auth_base_url": "https://sso.example.com"},
+			apiBaseURL:  "https://gateway.example.com",
+			authBaseURL: "https://sso.example.com",
+		},
+	}
+
+	providerSchema := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().Schema
+	for name, endpoint := range endpoints {
+		apiBaseURL, authBaseURL := getEndpoints(schema.TestResourceDataRaw(t, providerSchema, endpoint.config))
+		assert.Equal(t, endpoint.apiBaseURL, apiBaseURL, name)
+		assert.Equal(t, endpoint.authBaseURL, authBaseURL, name)
+	}
+	assert.Equal(t, "mypurecloud.de", getAPIDomain("https://api.mypurecloud.de"))
+}
+
+func TestUnitProviderAuthBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "stand-in-token", "expires_in": 86399}`))
+	}))
+	defer server.Close()
+
+	// Client credentials tokens are requested from the login URL even if it is not the one of the API base URL
+	providerSchema := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().Schema
+	data := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"api_base_url":       "https://api.example.com",
+		"auth_base_url":      server.URL,
+		"oauthclient_id":     "client-id",
+		"oauthclient_secret": "client-secret",
+	})
+	config := platformclientv2.NewConfiguration()
+	assert.Nil(t, InitClientConfig(data, "0.1.0", config))
+	assert.Equal(t, "https://api.example.com", config.BasePath)
+	assert.Equal(t, "stand-in-token", config.AccessToken)
+}
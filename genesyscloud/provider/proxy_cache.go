@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+)
+
+// ProxyCache holds a proxy instance per SDK client config. Each pooled client, and the clients of each provider instance, make
+// their requests through their own proxy so that the requests are spread across the tokens of the pool.
+type ProxyCache[T any] struct {
+	lock    sync.Mutex
+	proxies map[*platformclientv2.Configuration]*T
+}
+
+// Get returns the proxy of a client config, creating it with newProxy on first use
+func (c *ProxyCache[T]) Get(clientConfig *platformclientv2.Configuration, newProxy func(*platformclientv2.Configuration) *T) *T {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.proxies == nil {
+		c.proxies = make(map[*platformclientv2.Configuration]*T)
+	}
+	proxy, ok := c.proxies[clientConfig]
+	if !ok {
+		proxy = newProxy(clientConfig)
+		c.proxies[clientConfig] = proxy
+	}
+	return proxy
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitProxyCache(t *testing.T) {
+	type testProxy struct {
+		clientConfig *platformclientv2.Configuration
+	}
+	newTestProxy := func(clientConfig *platformclientv2.Configuration) *testProxy {
+		return &testProxy{clientConfig: clientConfig}
+	}
+
+	var cache ProxyCache[testProxy]
+	config1 := platformclientv2.NewConfiguration()
+	config2 := platformclientv2.NewConfiguration()
+
+	// Each client config gets its own proxy, which is reused
+	proxy1 := cache.Get(config1, newTestProxy)
+	assert.Same(t, config1, proxy1.clientConfig)
+	assert.Same(t, proxy1, cache.Get(config1, newTestProxy))
+	assert.NotSame(t, proxy1, cache.Get(config2, newTestProxy))
+	assert.Same(t, config2, cache.Get(config2, newTestProxy).clientConfig)
+}
@@ -2,9 +2,15 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"log"
+	"net/http"
+	"strconv"
 	"sync"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/ratelimit"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,31 +23,131 @@ import (
 // increases throughput as each token will have its own rate limit.
 type SDKClientPool struct {
 	Pool chan *platformclientv2.Configuration
+
+	// Every client config created for the pool
+	configs []*platformclientv2.Configuration
+
+	// The client config of the provider meta. It is never handed out by the pool, so callers that use the meta directly do not
+	// share a config with a pooled client.
+	metaConfig *platformclientv2.Configuration
+
+	// Identifies the org and credentials of the provider instance the pool was created for
+	key string
+
+	// Limits the requests of all the clients of the pool, as they share the rate limits of the org
+	limiter *ratelimit.Limiter
 }
 
+// SdkClientPool is the pool of the first configured provider instance. It is used by callers that do not have the
+// provider meta or a context carrying the pool of their provider instance.
 var SdkClientPool *SDKClientPool
 var SdkClientPoolErr diag.Diagnostics
 var Once sync.Once
 
-// InitSDKClientPool creates a new Pool of Clients with the given provider config
+// The pools of the configured provider instances keyed by their org and credentials. Provider instances configured with
+// the same credentials, such as the providers of consecutive test steps, share a pool. Aliased providers for different
+// orgs or regions each get their own pool.
+var (
+	sdkClientPools      = make(map[string]*SDKClientPool)
+	clientConfigPools   = make(map[*platformclientv2.Configuration]*SDKClientPool)
+	sdkClientPoolsMutex sync.Mutex
+)
+
+type clientPoolContextKey struct{}
+
+// InitSDKClientPool returns the Pool of Clients for the given provider config, creating it on first use.
 // This must be called during provider initialization before the Pool is used
-func InitSDKClientPool(max int, version string, providerConfig *schema.ResourceData) diag.Diagnostics {
+func InitSDKClientPool(max int, version string, providerConfig *schema.ResourceData) (*SDKClientPool, diag.Diagnostics) {
+	key := clientPoolKey(providerConfig, max)
+
+	sdkClientPoolsMutex.Lock()
+	defer sdkClientPoolsMutex.Unlock()
+	if pool, ok := sdkClientPools[key]; ok {
+		return pool, nil
+	}
+
 	Once.Do(func() {
 		log.Print("Initializing default SDK client.")
 		// Initialize the default config for tests and anything else that doesn't use the Pool
-		err := InitClientConfig(providerConfig, version, platformclientv2.GetDefaultConfiguration())
-		if err != nil {
-			SdkClientPoolErr = err
-			return
-		}
-
-		log.Printf("Initializing %d SDK clients in the Pool.", max)
-		SdkClientPool = &SDKClientPool{
-			Pool: make(chan *platformclientv2.Configuration, max),
-		}
-		SdkClientPoolErr = SdkClientPool.preFill(providerConfig, version)
+		SdkClientPoolErr = InitClientConfig(providerConfig, version, platformclientv2.GetDefaultConfiguration())
 	})
-	return SdkClientPoolErr
+	if SdkClientPoolErr != nil {
+		return nil, SdkClientPoolErr
+	}
+
+	log.Printf("Initializing %d SDK clients in the Pool.", max)
+	pool := &SDKClientPool{
+		Pool:    make(chan *platformclientv2.Configuration, max),
+		key:     key,
+		limiter: ratelimit.NewLimiter(float64(providerConfig.Get("max_requests_per_second").(int)), providerConfig.Get("max_concurrent_requests").(int)),
+	}
+	if err := pool.preFill(providerConfig, version); err != nil {
+		return nil, err
+	}
+
+	sdkClientPools[key] = pool
+	for _, clientConfig := range pool.configs {
+		clientConfigPools[clientConfig] = pool
+	}
+	clientConfigPools[pool.metaConfig] = pool
+	if SdkClientPool == nil {
+		SdkClientPool = pool
+	}
+	return pool, nil
+}
+
+// clientPoolKey identifies the org and credentials of a provider config without keeping the credentials themselves
+func clientPoolKey(providerConfig *schema.ResourceData, max int) string {
+	hash := sha256.New()
+	for _, attr := range []string{"aws_region", "api_base_url", "auth_base_url", "login_domain", "oauthclient_id", "oauthclient_secret", "access_token"} {
+		hash.Write([]byte(providerConfig.Get(attr).(string)))
+		hash.Write([]byte{0})
+	}
+	hash.Write([]byte(fmt.Sprint(providerConfig.Get("auth").(*schema.Set).List())))
+	hash.Write([]byte(strconv.Itoa(max)))
+	for _, attr := range []string{"max_requests_per_second", "max_concurrent_requests"} {
+		hash.Write([]byte{0})
+		hash.Write([]byte(strconv.Itoa(providerConfig.Get(attr).(int))))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ClientConfigOrgKey identifies the org and credentials a client config was created for. Every client of a pool has the key
+// of its pool. The key of any other config, such as the default config, is derived from its base path and client ID. The access
+// token of a config authorized with client credentials changes when it is refreshed, so it is only used without a client ID.
+func ClientConfigOrgKey(clientConfig *platformclientv2.Configuration) string {
+	sdkClientPoolsMutex.Lock()
+	defer sdkClientPoolsMutex.Unlock()
+	if pool, ok := clientConfigPools[clientConfig]; ok {
+		return pool.key
+	}
+	if clientConfig.ClientID != "" {
+		return clientConfig.BasePath + "|" + clientConfig.ClientID
+	}
+	return clientConfig.BasePath + "|" + clientConfig.AccessToken
+}
+
+// ContextWithClientPool returns a context that carries the pool of a provider instance to the exporter getAll* methods
+func ContextWithClientPool(ctx context.Context, pool *SDKClientPool) context.Context {
+	if pool == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, clientPoolContextKey{}, pool)
+}
+
+func clientPoolFromContext(ctx context.Context) *SDKClientPool {
+	if pool, ok := ctx.Value(clientPoolContextKey{}).(*SDKClientPool); ok {
+		return pool
+	}
+	return SdkClientPool
+}
+
+// clientPoolFromMeta returns the pool of the provider instance a resource method is run for
+func clientPoolFromMeta(meta interface{}) *SDKClientPool {
+	if providerMeta, ok := meta.(*ProviderMeta); ok && providerMeta.ClientPool != nil {
+		return providerMeta.ClientPool
+	}
+	return SdkClientPool
 }
 
 func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version string) diag.Diagnostics {
@@ -52,7 +158,11 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	for i := 0; i < cap(p.Pool); i++ {
-		sdkConfig := platformclientv2.NewConfiguration()
+		p.configs = append(p.configs, platformclientv2.NewConfiguration())
+	}
+	p.metaConfig = platformclientv2.NewConfiguration()
+	for _, sdkConfig := range append([]*platformclientv2.Configuration{p.metaConfig}, p.configs...) {
+		sdkConfig := sdkConfig
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -65,7 +175,10 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 				cancel()
 				return
 			}
+			p.limitRequests(sdkConfig)
 		}()
+	}
+	for _, sdkConfig := range p.configs {
 		p.Pool <- sdkConfig
 	}
 	go func() {
@@ -82,8 +195,71 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 	}
 }
 
+// limitRequests makes the requests of a client config of the pool wait for the limiter of the pool and adapt its rate to the
+// responses. The retry hooks are called by the SDK for every attempt of a request.
+func (p *SDKClientPool) limitRequests(sdkConfig *platformclientv2.Configuration) {
+	retryConfig := sdkConfig.RetryConfiguration
+	if retryConfig == nil {
+		return
+	}
+
+	requestLogHook := retryConfig.RequestLogHook
+	retryConfig.RequestLogHook = func(request *http.Request, count int) {
+		if request != nil {
+			if err := p.limiter.Wait(request.Context()); err != nil {
+				log.Printf("Stopped waiting to send request %s %s: %v", request.Method, request.URL, err)
+			}
+		}
+		if requestLogHook != nil {
+			requestLogHook(request, count)
+		}
+	}
+
+	responseLogHook := retryConfig.ResponseLogHook
+	retryConfig.ResponseLogHook = func(response *http.Response) {
+		p.limiter.ObserveResponse(response)
+		if responseLogHook != nil {
+			responseLogHook(response)
+		}
+	}
+}
+
+// MaxConcurrency returns how many clients of the pool can be used at the same time
+func (p *SDKClientPool) MaxConcurrency() int {
+	maxConcurrency := cap(p.Pool)
+	if p.limiter != nil && p.limiter.MaxConcurrentRequests() > 0 && p.limiter.MaxConcurrentRequests() < maxConcurrency {
+		maxConcurrency = p.limiter.MaxConcurrentRequests()
+	}
+	return maxConcurrency
+}
+
+// Throttle returns the throttle fed by the responses of the clients of the pool
+func (p *SDKClientPool) Throttle() *ratelimit.Throttle {
+	if p.limiter == nil {
+		return ratelimit.NewThrottle()
+	}
+	return p.limiter.Throttle()
+}
+
+// defaultConfig returns the client config of the pool for requests that are not run with a pooled client
+func (p *SDKClientPool) defaultConfig() *platformclientv2.Configuration {
+	return p.metaConfig
+}
+
 func (p *SDKClientPool) acquire() *platformclientv2.Configuration {
-	return <-p.Pool
+	if p.limiter != nil {
+		_ = p.limiter.Acquire(context.Background())
+	}
+	clientConfig := <-p.Pool
+	// Refresh the token of a client authorized by an auth method before it expires
+	if err := refreshAccessToken(clientConfig); err != nil {
+		log.Printf("Failed to refresh the access token of a pooled client: %v", err)
+	}
+	// The meta config has its own token. It is refreshed here as it is used without being acquired.
+	if err := refreshAccessToken(p.metaConfig); err != nil {
+		log.Printf("Failed to refresh the access token of the provider client: %v", err)
+	}
+	return clientConfig
 }
 
 func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
@@ -92,6 +268,9 @@ func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
 	default:
 		// Pool is full. Don't put it back in the Pool
 	}
+	if p.limiter != nil {
+		p.limiter.Release()
+	}
 }
 
 type resContextFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
@@ -118,8 +297,9 @@ func DeleteWithPooledClient(method resContextFunc) schema.DeleteContextFunc {
 // and automatically return it to the Pool on completion
 func runWithPooledClient(method resContextFunc) resContextFunc {
 	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool := clientPoolFromMeta(meta)
+		clientConfig := pool.acquire()
+		defer pool.release(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
@@ -131,15 +311,16 @@ func runWithPooledClient(method resContextFunc) resContextFunc {
 		// Copy to a new providerMeta object and set the sdk config
 		newMeta := *meta.(*ProviderMeta)
 		newMeta.ClientConfig = clientConfig
-		return method(ctx, r, &newMeta)
+		return method(ContextWithClientPool(ctx, pool), r, &newMeta)
 	}
 }
 
 // Inject a pooled SDK client connection into an exporter's getAll* method
 func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllResourcesFunc {
 	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool := clientPoolFromContext(ctx)
+		clientConfig := pool.acquire()
+		defer pool.release(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
@@ -154,8 +335,9 @@ func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllReso
 
 func GetAllWithPooledClientCustom(method GetCustomConfigFunc) resourceExporter.GetAllCustomResourcesFunc {
 	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool := clientPoolFromContext(ctx)
+		clientConfig := pool.acquire()
+		defer pool.release(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/util/ratelimit"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitSdkClientPoolPerProviderInstance(t *testing.T) {
+	providerSchema := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().Schema
+	prod := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"aws_region":         "us-east-1",
+		"oauthclient_id":     "prod-client",
+		"oauthclient_secret": "secret",
+	})
+	staging := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"aws_region":         "eu-west-1",
+		"oauthclient_id":     "staging-client",
+		"oauthclient_secret": "secret",
+	})
+
+	// Aliased providers for different orgs get different pools, providers with the same config share one
+	assert.NotEqual(t, clientPoolKey(prod, 10), clientPoolKey(staging, 10))
+	assert.NotEqual(t, clientPoolKey(prod, 10), clientPoolKey(prod, 1))
+	assert.Equal(t, clientPoolKey(prod, 10), clientPoolKey(prod, 10))
+
+	// The pool of a provider instance is carried by the context and the meta, falling back to the default pool
+	pool := &SDKClientPool{}
+	ctx := ContextWithClientPool(context.Background(), pool)
+	assert.Same(t, pool, clientPoolFromContext(ctx))
+	assert.Same(t, pool, clientPoolFromMeta(&ProviderMeta{ClientPool: pool}))
+	assert.Equal(t, SdkClientPool, clientPoolFromContext(context.Background()))
+	assert.Equal(t, SdkClientPool, clientPoolFromMeta(&ProviderMeta{}))
+}
+
+func TestUnitSdkClientPoolRateLimits(t *testing.T) {
+	providerSchema := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().Schema
+	unlimited := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"oauthclient_id":     "client",
+		"oauthclient_secret": "secret",
+	})
+	limited := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"oauthclient_id":          "client",
+		"oauthclient_secret":      "secret",
+		"max_requests_per_second": 20,
+		"max_concurrent_requests": 4,
+	})
+
+	// Provider instances with different limits do not share a pool
+	assert.NotEqual(t, clientPoolKey(unlimited, 10), clientPoolKey(limited, 10))
+
+	// The concurrency of a pool is capped by the concurrent requests limit
+	pool := &SDKClientPool{Pool: make(chan *platformclientv2.Configuration, 10), limiter: ratelimit.NewLimiter(20, 4)}
+	assert.Equal(t, 4, pool.MaxConcurrency())
+	pool.limiter = ratelimit.NewLimiter(0, 0)
+	assert.Equal(t, 10, pool.MaxConcurrency())
+
+	// The retry hooks of a pooled client config feed the limiter of the pool and still call the original hooks
+	var responses int
+	sdkConfig := platformclientv2.NewConfiguration()
+	sdkConfig.RetryConfiguration = &platformclientv2.RetryConfiguration{
+		ResponseLogHook: func(*http.Response) { responses++ },
+	}
+	pool.limiter = ratelimit.NewLimiter(20, 0)
+	pool.limitRequests(sdkConfig)
+	sdkConfig.RetryConfiguration.RequestLogHook(httptest.NewRequest(http.MethodGet, "/api/v2/users", nil), 0)
+	sdkConfig.RetryConfiguration.ResponseLogHook(&http.Response{StatusCode: http.StatusTooManyRequests, Header: make(http.Header)})
+	assert.Equal(t, 1, responses)
+	assert.Equal(t, 10.0, pool.limiter.Rate())
+}
+
+func TestUnitSdkClientPoolOrgKey(t *testing.T) {
+	// The key of a config authorized with client credentials does not change when its access token is refreshed
+	sdkConfig := platformclientv2.NewConfiguration()
+	sdkConfig.BasePath = "https://api.mypurecloud.com"
+	sdkConfig.ClientID = "client"
+	sdkConfig.AccessToken = "token-1"
+	key := ClientConfigOrgKey(sdkConfig)
+	sdkConfig.AccessToken = "token-2"
+	assert.Equal(t, key, ClientConfigOrgKey(sdkConfig))
+
+	// Configs without a client ID are identified by their access token
+	tokenConfig := platformclientv2.NewConfiguration()
+	tokenConfig.BasePath = sdkConfig.BasePath
+	tokenConfig.AccessToken = "token-1"
+	assert.NotEqual(t, key, ClientConfigOrgKey(tokenConfig))
+}
+
+func TestUnitSdkClientPoolMetaConfig(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.Nil(t, os.WriteFile(tokenFile, []byte(`{"access_token": "token-1", "expires_in": 3600}`), 0600))
+	data := testAuthProviderConfig(t, "", map[string]interface{}{
+		"method":     authMethodTokenFile,
+		"token_file": tokenFile,
+	})
+
+	pool := &SDKClientPool{Pool: make(chan *platformclientv2.Configuration, 2), limiter: ratelimit.NewLimiter(0, 0)}
+	assert.Nil(t, pool.preFill(data, "0.1.0"))
+	metaConfig := pool.defaultConfig()
+	assert.Equal(t, "token-1", metaConfig.AccessToken)
+
+	// The meta config is not one of the pooled configs
+	first := pool.acquire()
+	second := pool.acquire()
+	assert.NotSame(t, metaConfig, first)
+	assert.NotSame(t, metaConfig, second)
+	pool.release(first)
+	pool.release(second)
+
+	// Its token is refreshed on its own
+	assert.Nil(t, os.WriteFile(tokenFile, []byte("token-2\n"), 0600))
+	value, _ := authTokens.Load(metaConfig)
+	value.(*authToken).refreshAt = time.Now()
+	pool.release(pool.acquire())
+	assert.Equal(t, "token-2", metaConfig.AccessToken)
+	assert.Equal(t, "token-1", first.AccessToken)
+	assert.Equal(t, "token-1", second.AccessToken)
+}
@@ -3,6 +3,7 @@ package recording_media_retention_policy
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -28,9 +29,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *policyProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[policyProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllPoliciesFunc func(ctx context.Context, p *policyProxy) (*[]platformclientv2.Policy, *platformclientv2.APIResponse, error)
 type createPolicyFunc func(ctx context.Context, p *policyProxy, policyCreate *platformclientv2.Policycreate) (*platformclientv2.Policy, *platformclientv2.APIResponse, error)
@@ -78,13 +82,13 @@ func newPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
 	}
 }
 
-// getPolicyProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPolicyProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
-	if internalProxy == nil {
-		internalProxy = newPolicyProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newPolicyProxy)
 }
 
 // getAllPolicies retrieves all Genesys Cloud Recording Media Retention Policies
@@ -2,6 +2,7 @@ package resource_cache
 
 import (
 	"log"
+	"sync"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 )
 
@@ -20,6 +21,16 @@ func NewResourceCache[T any]() CacheInterface[T] {
 	}
 }
 
+// orgCaches holds the caches shared by the proxies of every client of an org, keyed by org and cache name
+var orgCaches sync.Map
+
+// GetOrgResourceCache returns the cache shared by the proxies of every client of an org, creating it on first use. The proxies of
+// the pooled clients of an org all read the resources cached by any one of them.
+func GetOrgResourceCache[T any](orgKey string, name string) CacheInterface[T] {
+	cache, _ := orgCaches.LoadOrStore(orgKey+"|"+name, NewResourceCache[T]())
+	return cache.(CacheInterface[T])
+}
+
 func SetCache[T any](cache CacheInterface[T], key string, value T) {
 	if tfexporter_state.IsExporterActive() {
 		cache.Set(key, value)
@@ -41,3 +41,17 @@ func TestUnitSetCacheAndGetCache(t *testing.T) {
 		t.Errorf("Expected key 'nonexistent' to not exist in the cache")
 	}
 }
+
+func TestUnitGetOrgResourceCache(t *testing.T) {
+	tfexporter_state.ActivateExporterState()
+	cache := GetOrgResourceCache[int]("org-1", "genesyscloud_routing_queue")
+	SetCache(cache, "key1", 10)
+
+	// Every proxy of the org shares the cache, the caches of other orgs are separate
+	if valPtr := GetCacheItem(GetOrgResourceCache[int]("org-1", "genesyscloud_routing_queue"), "key1"); valPtr == nil || *valPtr != 10 {
+		t.Errorf("Expected value %d for key 'key1' in the cache of the org, got %v", 10, valPtr)
+	}
+	if valPtr := GetCacheItem(GetOrgResourceCache[int]("org-2", "genesyscloud_routing_queue"), "key1"); valPtr != nil {
+		t.Errorf("Expected key 'key1' to not exist in the cache of another org, got %v", *valPtr)
+	}
+}
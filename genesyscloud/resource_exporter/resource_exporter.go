@@ -24,6 +24,10 @@ type ResourceMeta struct {
 
 	// Prefix to add to the ID when reading state
 	IdPrefix string
+
+	// Version or last modified date of the object as returned by GetResourcesFunc. This is optional. When set, incremental
+	// exports reuse the previously exported state of the object if the value has not changed since the last export.
+	Version string
 }
 
 // ResourceIDMetaMap is a map of IDs to ResourceMeta
@@ -127,6 +131,14 @@ type ResourceExporter struct {
 	// Map of attributes that cannot be resolved. E.g. edge Ids which are locked to an org or properties that cannot be retrieved from the API
 	UnResolvableAttributes map[string]*schema.Schema
 
+	// List of attributes with values that are specific to an org, e.g. phone numbers, email domains or site names. When exporting with
+	// parameterize enabled these values, along with all E164 attributes, are replaced with variables so the config can be promoted between orgs.
+	EnvironmentSpecificAttributes []string
+
+	// List of attributes holding secrets such as certificates. When exporting with a secret_reference_style these values, along with all
+	// sensitive UnResolvableAttributes, are replaced with references to a data source of an external secret store.
+	SecretAttributes []string
+
 	// List of attributes which can and should be exported in a jsonencode object rather than as a long escaped string of JSON data.
 	JsonEncodeAttributes []string
 
@@ -165,6 +177,22 @@ func (r *ResourceExporter) LoadSanitizedResourceMap(ctx context.Context, name st
 	return nil
 }
 
+// RemoveSanitizedResources removes the objects with the given state IDs from the sanitized resource map, so references to them are
+// no longer resolved to the exported resource
+func (r *ResourceExporter) RemoveSanitizedResources(stateIDs map[string]bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for id, meta := range r.SanitizedResourceMap {
+		idPrefix := ""
+		if meta != nil {
+			idPrefix = meta.IdPrefix
+		}
+		if stateIDs[idPrefix+id] {
+			delete(r.SanitizedResourceMap, id)
+		}
+	}
+}
+
 func (r *ResourceExporter) GetRefAttrSettings(attribute string) *RefAttrSettings {
 	if r.RefAttrs == nil {
 		return nil
@@ -219,6 +247,17 @@ func (r *ResourceExporter) IsAttributeRrule(attribute string) bool {
 	return lists.ItemInSlice(attribute, values)
 }
 
+func (r *ResourceExporter) IsAttributeEnvironmentSpecific(attribute string) bool {
+	return lists.ItemInSlice(attribute, r.EnvironmentSpecificAttributes) || r.IsAttributeE164(attribute)
+}
+
+func (r *ResourceExporter) IsAttributeSecret(attribute string) bool {
+	if attr, ok := r.UnResolvableAttributes[attribute]; ok && attr.Sensitive {
+		return true
+	}
+	return lists.ItemInSlice(attribute, r.SecretAttributes)
+}
+
 func (r *ResourceExporter) AddExcludedAttribute(attribute string) {
 	r.ExcludedAttributes = append(r.ExcludedAttributes, attribute)
 }
@@ -1,8 +1,11 @@
 package resource_exporter
 
 import (
+	"fmt"
+	"hash/fnv"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -290,3 +293,91 @@ func TestUnitSanitizeResourceNameOptimized(t *testing.T) {
 		}
 	}
 }
+
+// sanitizeQuadratic is the previous implementation of the optimized sanitizer, which re-sanitizes every original name for every
+// resource. It is kept to check that the count based implementation produces the same names and to compare their performance.
+func sanitizeQuadratic(idMetaMap ResourceIDMetaMap) {
+	sod := &sanitizerOptimized{}
+	originalResourceNames := make(map[string]string)
+	for k, v := range idMetaMap {
+		originalResourceNames[k] = v.Name
+	}
+
+	for _, meta := range idMetaMap {
+		sanitizedName := sod.SanitizeResourceName(meta.Name)
+		if sanitizedName != meta.Name {
+			numSeen := 0
+			for _, originalName := range originalResourceNames {
+				if sanitizedName == sod.SanitizeResourceName(originalName) {
+					numSeen++
+				}
+			}
+			if numSeen > 1 {
+				algorithm := fnv.New32()
+				algorithm.Write([]byte(meta.Name))
+				sanitizedName = sanitizedName + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+			}
+			meta.Name = sanitizedName
+		}
+	}
+}
+
+// buildSanitizerTestNames returns a map of resource names with safe names, unsafe names and unsafe names that collide once sanitized
+func buildSanitizerTestNames(count int) ResourceIDMetaMap {
+	metaMap := make(ResourceIDMetaMap, count)
+	for i := 0; i < count; i++ {
+		var name string
+		switch i % 4 {
+		case 0:
+			name = fmt.Sprintf("user%d", i)
+		case 1:
+			name = fmt.Sprintf("User %d", i)
+		case 2:
+			name = fmt.Sprintf("User/%d", i-1)
+		case 3:
+			name = fmt.Sprintf("%d Main St. #%d", i%100, i)
+		}
+		metaMap[strconv.Itoa(i)] = &ResourceMeta{Name: name}
+	}
+	return metaMap
+}
+
+func TestUnitSanitizeResourceNamesOptimizedMatchesQuadratic(t *testing.T) {
+	expected := buildSanitizerTestNames(1000)
+	actual := buildSanitizerTestNames(1000)
+
+	sanitizeQuadratic(expected)
+	(&sanitizerOptimized{}).Sanitize(actual)
+
+	for id, meta := range expected {
+		if actual[id].Name != meta.Name {
+			t.Errorf("Sanitized name of %s does not match. Expected %s, got %s", id, meta.Name, actual[id].Name)
+		}
+	}
+}
+
+// BenchmarkSanitizeResourceNames compares the count based sanitizer with the previous quadratic implementation. The quadratic
+// implementation is only run up to 10,000 names as it takes minutes of CPU per run at 100,000 names.
+func BenchmarkSanitizeResourceNames(b *testing.B) {
+	for _, count := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("optimized/names=%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				metaMap := buildSanitizerTestNames(count)
+				b.StartTimer()
+				(&sanitizerOptimized{}).Sanitize(metaMap)
+			}
+		})
+	}
+
+	for _, count := range []int{1000, 10000} {
+		b.Run(fmt.Sprintf("quadratic/names=%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				metaMap := buildSanitizerTestNames(count)
+				b.StartTimer()
+				sanitizeQuadratic(metaMap)
+			}
+		})
+	}
+}
@@ -67,28 +67,23 @@ func (so *sanitizerOriginal) SanitizeResourceName(inputName string) string {
 
 // Sanitize sanitizes all resource name using the optimized algorithm
 func (sod *sanitizerOptimized) Sanitize(idMetaMap ResourceIDMetaMap) {
-	// Pull out all the original names of the resources for reference later
-	originalResourceNames := make(map[string]string)
-	for k, v := range idMetaMap {
-		originalResourceNames[k] = v.Name
+	// Sanitize every original name once and count how many of the original names end up with the same sanitized name
+	sanitizedNames := make(map[string]string, len(idMetaMap))
+	sanitizedNameCounts := make(map[string]int, len(idMetaMap))
+	for id, meta := range idMetaMap {
+		sanitizedName := sod.SanitizeResourceName(meta.Name)
+		sanitizedNames[id] = sanitizedName
+		sanitizedNameCounts[sanitizedName]++
 	}
 
 	// Iterate over the idMetaMap and sanitize the names of each resource
-	for _, meta := range idMetaMap {
-
-		sanitizedName := sod.SanitizeResourceName(meta.Name)
+	for id, meta := range idMetaMap {
+		sanitizedName := sanitizedNames[id]
 
 		// If there are more than one resource name that ends up with the same sanitized name,
 		// append a hash of the original name to ensure uniqueness for names to prevent duplicates
 		if sanitizedName != meta.Name {
-			numSeen := 0
-			for _, originalName := range originalResourceNames {
-				originalSanitizedName := sod.SanitizeResourceName(originalName)
-				if sanitizedName == originalSanitizedName {
-					numSeen++
-				}
-			}
-			if numSeen > 1 {
+			if sanitizedNameCounts[sanitizedName] > 1 {
 				algorithm := fnv.New32()
 				algorithm.Write([]byte(meta.Name))
 				sanitizedName = sanitizedName + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
@@ -39,7 +40,11 @@ func getAllArchitectSchedules(_ context.Context, clientConfig *platformclientv2.
 		}
 
 		for _, schedule := range *schedules.Entities {
-			resources[*schedule.Id] = &resourceExporter.ResourceMeta{Name: *schedule.Name}
+			resourceMeta := &resourceExporter.ResourceMeta{Name: *schedule.Name}
+			if schedule.Version != nil {
+				resourceMeta.Version = strconv.Itoa(*schedule.Version)
+			}
+			resources[*schedule.Id] = resourceMeta
 		}
 	}
 
@@ -42,6 +42,7 @@ func IdpAdfsExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllIdpAdfs),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		SecretAttributes: []string{"certificates"},
 	}
 }
 
@@ -43,6 +43,7 @@ func IdpGenericExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllIdpGeneric),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		SecretAttributes: []string{"certificates"},
 	}
 }
 
@@ -42,6 +42,7 @@ func IdpGsuiteExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllIdpGsuite),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		SecretAttributes: []string{"certificates"},
 	}
 }
 
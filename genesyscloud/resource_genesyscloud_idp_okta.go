@@ -42,6 +42,7 @@ func IdpOktaExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllIdpOkta),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		SecretAttributes: []string{"certificates"},
 	}
 }
 
@@ -50,6 +50,7 @@ func RoutingEmailDomainExporter() *resourceExporter.ResourceExporter {
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"custom_smtp_server_id": ResourceRoutingEmailDomain().Schema["custom_smtp_server_id"],
 		},
+		EnvironmentSpecificAttributes: []string{"domain_id"},
 	}
 }
 
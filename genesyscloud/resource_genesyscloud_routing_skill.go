@@ -37,7 +37,11 @@ func getAllRoutingSkills(_ context.Context, clientConfig *platformclientv2.Confi
 
 		for _, skill := range *skills.Entities {
 			if skill.State != nil && *skill.State != "deleted" {
-				resources[*skill.Id] = &resourceExporter.ResourceMeta{Name: *skill.Name}
+				resourceMeta := &resourceExporter.ResourceMeta{Name: *skill.Name}
+				if skill.Version != nil {
+					resourceMeta.Version = *skill.Version
+				}
+				resources[*skill.Id] = resourceMeta
 			}
 		}
 	}
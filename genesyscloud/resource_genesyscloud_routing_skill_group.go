@@ -246,7 +246,7 @@ func postSkillGroupMemberDivisions(ctx context.Context, d *schema.ResourceData,
 		return diagErr
 	}
 
-	toRemove, diagErr = removeSkillGroupDivisionID(d, toRemove)
+	toRemove, diagErr = removeSkillGroupDivisionID(d, toRemove, meta.(*provider.ProviderMeta).ClientConfig)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -571,13 +571,13 @@ func organizeMemberDivisionIdsForRead(schemaList, apiList []string, divisionId s
 }
 
 // Remove the value of division_id, or if this field was left blank; the home division ID
-func removeSkillGroupDivisionID(d *schema.ResourceData, list []string) ([]string, diag.Diagnostics) {
+func removeSkillGroupDivisionID(d *schema.ResourceData, list []string, sdkConfig *platformclientv2.Configuration) ([]string, diag.Diagnostics) {
 	if len(list) == 0 || list == nil {
 		return list, nil
 	}
 	divisionId := d.Get("division_id").(string)
 	if divisionId == "" {
-		id, diagErr := util.GetHomeDivisionID()
+		id, diagErr := util.GetHomeDivisionIDWithConfig(sdkConfig)
 		if diagErr != nil {
 			return nil, diagErr
 		}
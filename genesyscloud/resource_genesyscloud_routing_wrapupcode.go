@@ -36,7 +36,11 @@ func getAllRoutingWrapupCodes(_ context.Context, clientConfig *platformclientv2.
 		}
 
 		for _, wrapupcode := range *wrapupcodes.Entities {
-			resources[*wrapupcode.Id] = &resourceExporter.ResourceMeta{Name: *wrapupcode.Name}
+			resourceMeta := &resourceExporter.ResourceMeta{Name: *wrapupcode.Name}
+			if wrapupcode.DateModified != nil {
+				resourceMeta.Version = wrapupcode.DateModified.String()
+			}
+			resources[*wrapupcode.Id] = resourceMeta
 		}
 	}
 
@@ -174,12 +174,19 @@ func GetAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 
 	// Add resources to metamap
 	for _, user := range allUsers {
-		resources[*user.Id] = &resourceExporter.ResourceMeta{Name: *user.Email}
+		resources[*user.Id] = userResourceMeta(user)
 	}
 
 	return resources, nil
 }
 
+// userResourceMeta returns the export metadata of a user. No version is reported, since the routing skills, languages and
+// utilization of a user are changed through their own endpoints without changing the version of the user. Incremental exports
+// therefore always read users.
+func userResourceMeta(user platformclientv2.User) *resourceExporter.ResourceMeta {
+	return &resourceExporter.ResourceMeta{Name: *user.Email}
+}
+
 func UserExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(GetAllUsers),
@@ -195,7 +202,8 @@ func UserExporter() *resourceExporter.ResourceExporter {
 			"routing_languages": {"language_id"},
 			"locations":         {"location_id"},
 		},
-		AllowZeroValues: []string{"routing_skills.proficiency", "routing_languages.proficiency"},
+		AllowZeroValues:               []string{"routing_skills.proficiency", "routing_languages.proficiency"},
+		EnvironmentSpecificAttributes: []string{"addresses.phone_numbers.number"},
 	}
 }
 
@@ -0,0 +1,19 @@
+package genesyscloud
+
+import (
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitUserResourceMetaHasNoVersion(t *testing.T) {
+	email := "jane@example.com"
+	version := 3
+	user := platformclientv2.User{Email: &email, Version: &version}
+
+	// Skills, languages and utilization do not change the version of a user, so incremental exports must always read users
+	resourceMeta := userResourceMeta(user)
+	assert.Equal(t, email, resourceMeta.Name)
+	assert.Empty(t, resourceMeta.Version)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -13,9 +14,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *responsemanagementLibraryProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[responsemanagementLibraryProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createResponsemanagementLibraryFunc func(ctx context.Context, p *responsemanagementLibraryProxy, library *platformclientv2.Library) (*platformclientv2.Library, *platformclientv2.APIResponse, error)
 type getAllResponsemanagementLibraryFunc func(ctx context.Context, p *responsemanagementLibraryProxy, name string) (*[]platformclientv2.Library, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newResponsemanagementLibraryProxy(clientConfig *platformclientv2.Configurat
 	}
 }
 
-// getResponsemanagementLibraryProxy acts as a singleton to for the internalProxy.  It also ensures
+// getResponsemanagementLibraryProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getResponsemanagementLibraryProxy(clientConfig *platformclientv2.Configuration) *responsemanagementLibraryProxy {
-	if internalProxy == nil {
-		internalProxy = newResponsemanagementLibraryProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newResponsemanagementLibraryProxy)
 }
 
 // createResponsemanagementLibrary creates a Genesys Cloud responsemanagement library
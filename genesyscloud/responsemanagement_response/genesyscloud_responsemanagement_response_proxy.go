@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -13,9 +14,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *responsemanagementResponseProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[responsemanagementResponseProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createResponsemanagementResponseFunc func(ctx context.Context, p *responsemanagementResponseProxy, response *platformclientv2.Response) (responseManagementResponse *platformclientv2.Response, resp *platformclientv2.APIResponse, err error)
 type getAllResponsemanagementResponseFunc func(ctx context.Context, p *responsemanagementResponseProxy, libraryId string) (*[]platformclientv2.Response, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newResponsemanagementResponseProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// getResponsemanagementResponseProxy acts as a singleton to for the internalProxy.  It also ensures
+// getResponsemanagementResponseProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getResponsemanagementResponseProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseProxy {
-	if internalProxy == nil {
-		internalProxy = newResponsemanagementResponseProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newResponsemanagementResponseProxy)
 }
 
 // createResponsemanagementResponse creates a Genesys Cloud responsemanagement response
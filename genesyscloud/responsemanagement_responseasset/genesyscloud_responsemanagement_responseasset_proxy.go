@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
@@ -15,9 +16,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *responsemanagementResponseassetProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[responsemanagementResponseassetProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllResponseAssetsFunc func(ctx context.Context, p *responsemanagementResponseassetProxy) (*[]platformclientv2.Responseasset, *platformclientv2.APIResponse, error)
 type createRespManagementRespAssetFunc func(ctx context.Context, p *responsemanagementResponseassetProxy, respAsset *platformclientv2.Createresponseassetrequest) (*platformclientv2.Createresponseassetresponse, *platformclientv2.APIResponse, error)
@@ -42,7 +46,7 @@ type responsemanagementResponseassetProxy struct {
 // newRespManagementRespAssetProxy initializes the responsemanagement responseasset proxy with all of the data needed to communicate with Genesys Cloud
 func newRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseassetProxy {
 	api := platformclientv2.NewResponseManagementApiWithConfig(clientConfig)
-	assetCache := rc.NewResourceCache[platformclientv2.Responseasset]()
+	assetCache := rc.GetOrgResourceCache[platformclientv2.Responseasset](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_responsemanagement_responseasset")
 	return &responsemanagementResponseassetProxy{
 		clientConfig:                         clientConfig,
 		responseManagementApi:                api,
@@ -56,13 +60,13 @@ func newRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getRespManagementRespAssetProxy acts as a singleton to for the internalProxy.  It also ensures
+// getRespManagementRespAssetProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseassetProxy {
-	if internalProxy == nil {
-		internalProxy = newRespManagementRespAssetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newRespManagementRespAssetProxy)
 }
 
 func (p *responsemanagementResponseassetProxy) getAllResponseAssets(ctx context.Context) (*[]platformclientv2.Responseasset, *platformclientv2.APIResponse, error) {
@@ -3,6 +3,7 @@ package routing_queue
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
@@ -14,9 +15,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *RoutingQueueProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[RoutingQueueProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllRoutingQueuesFunc func(ctx context.Context, p *RoutingQueueProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error)
 type getRoutingQueueByIdFunc func(ctx context.Context, p *RoutingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
@@ -35,7 +39,7 @@ type RoutingQueueProxy struct {
 // newRoutingQueuesProxy initializes the routing queue proxy with all the data needed to communicate with Genesys Cloud
 func newRoutingQueuesProxy(clientConfig *platformclientv2.Configuration) *RoutingQueueProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
-	routingQueueCache := rc.NewResourceCache[platformclientv2.Queue]()
+	routingQueueCache := rc.GetOrgResourceCache[platformclientv2.Queue](provider.ClientConfigOrgKey(clientConfig), "genesyscloud_routing_queue")
 
 	return &RoutingQueueProxy{
 		clientConfig:                     clientConfig,
@@ -47,13 +51,13 @@ func newRoutingQueuesProxy(clientConfig *platformclientv2.Configuration) *Routin
 	}
 }
 
-// GetRoutingQueueProxy acts as a singleton to for the internalProxy.  It also ensures
+// GetRoutingQueueProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func GetRoutingQueueProxy(clientConfig *platformclientv2.Configuration) *RoutingQueueProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueuesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newRoutingQueuesProxy)
 }
 
 // GetAllRoutingQueues retrieves all Genesys Cloud routing queues
@@ -43,12 +43,19 @@ func getAllRoutingQueues(ctx context.Context, clientConfig *platformclientv2.Con
 	}
 
 	for _, queue := range *queues {
-		resources[*queue.Id] = &resourceExporter.ResourceMeta{Name: *queue.Name}
+		resources[*queue.Id] = queueResourceMeta(queue)
 	}
 
 	return resources, nil
 }
 
+// queueResourceMeta returns the export metadata of a queue. No version is reported, since the members and wrap-up codes of a
+// queue are changed through their own endpoints without changing the modified date of the queue. Incremental exports therefore
+// always read queues.
+func queueResourceMeta(queue platformclientv2.Queue) *resourceExporter.ResourceMeta {
+	return &resourceExporter.ResourceMeta{Name: *queue.Name}
+}
+
 func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
@@ -0,0 +1,20 @@
+package routing_queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRoutingQueueResourceMetaHasNoVersion(t *testing.T) {
+	name := "Support"
+	dateModified := time.Now()
+	queue := platformclientv2.Queue{Name: &name, DateModified: &dateModified}
+
+	// Members and wrap-up codes do not change the modified date of a queue, so incremental exports must always read queues
+	resourceMeta := queueResourceMeta(queue)
+	assert.Equal(t, name, resourceMeta.Name)
+	assert.Empty(t, resourceMeta.Version)
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *routingQueueOutboundEmailAddressProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[routingQueueOutboundEmailAddressProxy]
+
 type getRoutingQueueOutboundEmailAddressFunc func(ctx context.Context, p *routingQueueOutboundEmailAddressProxy, queueId string) (*platformclientv2.Queueemailaddress, *platformclientv2.APIResponse, error)
 type updateRoutingQueueOutboundEmailAddressFunc func(ctx context.Context, p *routingQueueOutboundEmailAddressProxy, queueId string, address *platformclientv2.Queueemailaddress) (*platformclientv2.Queueemailaddress, *platformclientv2.APIResponse, error)
 
@@ -38,11 +42,10 @@ func newRoutingQueueOutboundEmailAddressProxy(clientConfig *platformclientv2.Con
 }
 
 func getRoutingQueueOutboundEmailAddressProxy(clientConfig *platformclientv2.Configuration) *routingQueueOutboundEmailAddressProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueueOutboundEmailAddressProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newRoutingQueueOutboundEmailAddressProxy)
 }
 
 // getRoutingQueueOutboundEmailAddress gets the Outbound Email Address for a queue
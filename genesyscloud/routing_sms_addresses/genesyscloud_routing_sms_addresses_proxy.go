@@ -3,6 +3,7 @@ package genesyscloud
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -24,9 +25,12 @@ type routingSmsAddressProxy struct {
 	deleteSmsAddressByIdAttr  deleteSmsAddressByIdFunc
 }
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *routingSmsAddressProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[routingSmsAddressProxy]
+
 // newRoutingSmsAddressProxy initializes the sms address proxy with all of the data needed to communicate with Genesys Cloud
 func newRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *routingSmsAddressProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
@@ -40,13 +44,13 @@ func newRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *ro
 	}
 }
 
-// getRoutingSmsAddressProxy acts as a singleton for the internalProxy.  It also ensures
+// getRoutingSmsAddressProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *routingSmsAddressProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSmsAddressProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newRoutingSmsAddressProxy)
 }
 
 // createSmsAddress creates a Genesys Cloud Sms Address
@@ -29,7 +29,11 @@ func getAllScripts(ctx context.Context, clientConfig *platformclientv2.Configura
 	}
 
 	for _, script := range *scripts {
-		resources[*script.Id] = &resourceExporter.ResourceMeta{Name: *script.Name}
+		resourceMeta := &resourceExporter.ResourceMeta{Name: *script.Name}
+		if script.ModifiedDate != nil {
+			resourceMeta.Version = script.ModifiedDate.String()
+		}
+		resources[*script.Id] = resourceMeta
 	}
 
 	return resources, nil
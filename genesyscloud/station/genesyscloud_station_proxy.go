@@ -3,13 +3,17 @@ package station
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *stationProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[stationProxy]
+
 type getStationIdByNameFunc func(ctx context.Context, p *stationProxy, stationName string) (stationId string, retryable bool, resp *platformclientv2.APIResponse, err error)
 
 // stationProxy contains all of the methods that call genesys cloud APIs.
@@ -30,13 +34,13 @@ func newStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy
 	}
 }
 
-// getStationProxy acts as a singleton to for the internalProxy.  It also ensures
+// getStationProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy {
-	if internalProxy == nil {
-		internalProxy = newStationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newStationProxy)
 }
 
 // getStationIdByName retrieves a Genesys Cloud Station ID by Name
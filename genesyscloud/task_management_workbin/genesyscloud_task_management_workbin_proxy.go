@@ -3,6 +3,7 @@ package task_management_workbin
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -13,9 +14,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *taskManagementWorkbinProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[taskManagementWorkbinProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkbinFunc func(ctx context.Context, p *taskManagementWorkbinProxy, workbin *platformclientv2.Workbincreate) (*platformclientv2.Workbin, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkbinFunc func(ctx context.Context, p *taskManagementWorkbinProxy) (*[]platformclientv2.Workbin, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration)
 	}
 }
 
-// getTaskManagementWorkbinProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorkbinProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkbinProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkbinProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTaskManagementWorkbinProxy)
 }
 
 // createTaskManagementWorkbin creates a Genesys Cloud task management workbin
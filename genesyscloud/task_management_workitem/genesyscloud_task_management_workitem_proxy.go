@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -14,9 +15,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *taskManagementWorkitemProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[taskManagementWorkitemProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkitemFunc func(ctx context.Context, p *taskManagementWorkitemProxy, workitem *platformclientv2.Workitemcreate) (*platformclientv2.Workitem, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkitemFunc func(ctx context.Context, p *taskManagementWorkitemProxy) (*[]platformclientv2.Workitem, *platformclientv2.APIResponse, error)
@@ -52,13 +56,13 @@ func newTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// getTaskManagementWorkitemProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorkitemProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkitemProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkitemProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTaskManagementWorkitemProxy)
 }
 
 // createTaskManagementWorkitem creates a Genesys Cloud task management workitem
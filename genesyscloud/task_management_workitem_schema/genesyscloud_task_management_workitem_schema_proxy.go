@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -17,9 +18,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *taskManagementProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[taskManagementProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkitemSchemaFunc func(ctx context.Context, p *taskManagementProxy, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkitemSchemaFunc func(ctx context.Context, p *taskManagementProxy) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
@@ -58,13 +62,13 @@ func newTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskM
 	}
 }
 
-// getTaskManagementProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskManagementProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTaskManagementProxy)
 }
 
 // createTaskManagementWorkitemSchema creates a Genesys Cloud task management workitem schema
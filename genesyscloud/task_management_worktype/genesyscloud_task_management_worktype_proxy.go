@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -14,9 +15,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *taskManagementWorktypeProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[taskManagementWorktypeProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 
 type createTaskManagementWorktypeFunc func(ctx context.Context, p *taskManagementWorktypeProxy, worktype *platformclientv2.Worktypecreate) (*platformclientv2.Worktype, *platformclientv2.APIResponse, error)
@@ -67,13 +71,13 @@ func newTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// getTaskManagementWorktypeProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorktypeProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorktypeProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorktypeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTaskManagementWorktypeProxy)
 }
 
 // createTaskManagementWorktype creates a Genesys Cloud task management worktype
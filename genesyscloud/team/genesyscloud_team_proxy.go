@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
@@ -15,9 +16,12 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *teamProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[teamProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTeamFunc func(ctx context.Context, p *teamProxy, team *platformclientv2.Team) (*platformclientv2.Team, *platformclientv2.APIResponse, error)
 type getAllTeamFunc func(ctx context.Context, p *teamProxy, name string) (*[]platformclientv2.Team, *platformclientv2.APIResponse, error)
@@ -62,13 +66,13 @@ func newTeamProxy(clientConfig *platformclientv2.Configuration) *teamProxy {
 	}
 }
 
-// getTeamProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTeamProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTeamProxy(clientConfig *platformclientv2.Configuration) *teamProxy {
-	if internalProxy == nil {
-		internalProxy = newTeamProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTeamProxy)
 }
 
 // createTeam creates a Genesys Cloud team
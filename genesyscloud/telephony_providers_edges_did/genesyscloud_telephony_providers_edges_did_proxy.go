@@ -3,6 +3,7 @@ package telephony_providers_edges_did
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -28,9 +29,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *telephonyProvidersEdgesDidProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[telephonyProvidersEdgesDidProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getTelephonyProvidersEdgesDidIdByDidFunc func(ctx context.Context, t *telephonyProvidersEdgesDidProxy, did string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error)
 
@@ -51,13 +55,13 @@ func newTelephonyProvidersEdgesDidProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// getTelephonyProvidersEdgesDidProxy acts as a singleton for the internalProxy.  It also ensures
+// getTelephonyProvidersEdgesDidProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTelephonyProvidersEdgesDidProxy(clientConfig *platformclientv2.Configuration) *telephonyProvidersEdgesDidProxy {
-	if internalProxy == nil {
-		internalProxy = newTelephonyProvidersEdgesDidProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTelephonyProvidersEdgesDidProxy)
 }
 
 // getTelephonyProvidersEdgesDidIdByDid gets a Genesys Cloud telephony DID ID by DID number
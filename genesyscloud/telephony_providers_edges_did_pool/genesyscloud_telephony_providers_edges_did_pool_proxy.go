@@ -3,6 +3,7 @@ package telephony_providers_edges_did_pool
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -28,9 +29,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *telephonyDidPoolProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[telephonyDidPoolProxy]
+
 // Type definitions for each func on our proxy, so we can easily mock them out later
 type createTelephonyDidPool func(ctx context.Context, t *telephonyDidPoolProxy, didPool *platformclientv2.Didpool) (*platformclientv2.Didpool, *platformclientv2.APIResponse, error)
 type getTelephonyDidPoolById func(context.Context, *telephonyDidPoolProxy, string) (didPool *platformclientv2.Didpool, resp *platformclientv2.APIResponse, err error)
@@ -66,13 +70,13 @@ func newTelephonyProvidersEdgesDidPoolProxy(clientConfig *platformclientv2.Confi
 	}
 }
 
-// getTelephonyDidPoolProxy acts as a singleton for the internalProxy. It also ensures
+// getTelephonyDidPoolProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTelephonyDidPoolProxy(clientConfig *platformclientv2.Configuration) *telephonyDidPoolProxy {
-	if internalProxy == nil {
-		internalProxy = newTelephonyProvidersEdgesDidPoolProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTelephonyProvidersEdgesDidPoolProxy)
 }
 
 // createTelephonyDidPool creates a Genesys Cloud did pool
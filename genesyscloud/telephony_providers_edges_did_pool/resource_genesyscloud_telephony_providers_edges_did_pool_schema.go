@@ -21,8 +21,9 @@ func SetRegistrar(l registrar.Registrar) {
 // TelephonyDidPoolExporter returns the resourceExporter object used to hold the genesyscloud_telephony_providers_edges_did_pool exporter's config
 func TelephonyDidPoolExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
-		GetResourcesFunc: provider.GetAllWithPooledClient(getAllDidPools),
-		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
+		GetResourcesFunc:              provider.GetAllWithPooledClient(getAllDidPools),
+		RefAttrs:                      map[string]*resourceExporter.RefAttrSettings{}, // No references
+		EnvironmentSpecificAttributes: []string{"start_phone_number", "end_phone_number"},
 	}
 }
 
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
 var internalProxy *edgeGroupProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[edgeGroupProxy]
+
 type getEdgeGroupByIdFunc func(ctx context.Context, p *edgeGroupProxy, edgeGroupId string) (*platformclientv2.Edgegroup, *platformclientv2.APIResponse, error)
 type deleteEdgeGroupFunc func(ctx context.Context, p *edgeGroupProxy, edgeGroupId string) (*platformclientv2.APIResponse, error)
 type updateEdgeGroupFunc func(ctx context.Context, p *edgeGroupProxy, edgeGroupId string, body platformclientv2.Edgegroup) (*platformclientv2.Edgegroup, *platformclientv2.APIResponse, error)
@@ -46,10 +50,10 @@ func newEdgeGroupProxy(clientConfig *platformclientv2.Configuration) *edgeGroupP
 }
 
 func getEdgeGroupProxy(clientConfig *platformclientv2.Configuration) *edgeGroupProxy {
-	if internalProxy == nil {
-		internalProxy = newEdgeGroupProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newEdgeGroupProxy)
 }
 
 func (p *edgeGroupProxy) getEdgeGroupById(ctx context.Context, edgeGroupId string) (*platformclientv2.Edgegroup, *platformclientv2.APIResponse, error) {
@@ -2,12 +2,16 @@ package telephony_providers_edges_extension_pool
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
 var internalProxy *extensionPoolProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[extensionPoolProxy]
+
 type getExtensionPoolFunc func(ctxctx context.Context, p *extensionPoolProxy, extensionPoolId string) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error)
 type deleteExtensionPoolFunc func(ctx context.Context, p *extensionPoolProxy, extensionPoolId string) (*platformclientv2.APIResponse, error)
 type updateExtensionPoolFunc func(ctx context.Context, p *extensionPoolProxy, extensionPoolId string, body platformclientv2.Extensionpool) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error)
@@ -42,10 +46,10 @@ func newExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extens
 }
 
 func getExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extensionPoolProxy {
-	if internalProxy == nil {
-		internalProxy = newExtensionPoolProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newExtensionPoolProxy)
 }
 
 func (p *extensionPoolProxy) getExtensionPool(ctx context.Context, extensionPoolId string) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
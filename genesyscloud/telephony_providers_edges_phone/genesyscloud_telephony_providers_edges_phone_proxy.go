@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -30,9 +31,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *phoneProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[phoneProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllPhonesFunc func(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error)
 type createPhoneFunc func(ctx context.Context, p *phoneProxy, phoneConfig *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error)
@@ -95,13 +99,13 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 	}
 }
 
-// getPhoneProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPhoneProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
-	if internalProxy == nil {
-		internalProxy = newPhoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newPhoneProxy)
 }
 
 // getAllPhones retrieves all Genesys Cloud Phones
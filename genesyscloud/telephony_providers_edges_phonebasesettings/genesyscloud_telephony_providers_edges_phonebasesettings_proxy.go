@@ -2,12 +2,16 @@ package telephony_providers_edges_phonebasesettings
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
 var internalProxy *phoneBaseProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[phoneBaseProxy]
+
 type getPhoneBaseSettingFunc func(ctx context.Context, p *phoneBaseProxy, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error)
 type deletePhoneBaseSettingFunc func(ctx context.Context, p *phoneBaseProxy, phoneBaseSettingsId string) (*platformclientv2.APIResponse, error)
 type putPhoneBaseSettingFunc func(ctx context.Context, p *phoneBaseProxy, phoneBaseSettingsId string, body platformclientv2.Phonebase) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error)
@@ -42,13 +46,13 @@ func newphoneBaseProxy(clientConfig *platformclientv2.Configuration) *phoneBaseP
 	}
 }
 
-// getPhoneBaseProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPhoneBaseProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPhoneBaseProxy(clientConfig *platformclientv2.Configuration) *phoneBaseProxy {
-	if internalProxy == nil {
-		internalProxy = newphoneBaseProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newphoneBaseProxy)
 }
 
 func (p *phoneBaseProxy) getPhoneBaseSetting(ctx context.Context, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
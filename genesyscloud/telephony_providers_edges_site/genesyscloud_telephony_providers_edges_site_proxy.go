@@ -3,6 +3,7 @@ package telephony_providers_edges_site
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
@@ -28,9 +29,12 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *siteProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[siteProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllManagedSitesFunc func(ctx context.Context, p *siteProxy) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error)
 type getAllUnmanagedSitesFunc func(ctx context.Context, p *siteProxy) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error)
@@ -120,13 +124,13 @@ func newSiteProxy(clientConfig *platformclientv2.Configuration) *siteProxy {
 	}
 }
 
-// getSiteProxy acts as a singleton for the internalProxy.  It also ensures
+// getSiteProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getSiteProxy(clientConfig *platformclientv2.Configuration) *siteProxy {
-	if internalProxy == nil {
-		internalProxy = newSiteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newSiteProxy)
 }
 
 // getAllManagedSitesFunc retrieves all managed Genesys Cloud Sites
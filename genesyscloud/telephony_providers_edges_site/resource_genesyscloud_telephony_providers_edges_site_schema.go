@@ -287,6 +287,7 @@ func SiteExporter() *resourceExporter.ResourceExporter {
 		CustomValidateExports: map[string][]string{
 			"rrule": {"edge_auto_update_config.rrule"},
 		},
+		EnvironmentSpecificAttributes: []string{"name"},
 	}
 }
 
@@ -2,15 +2,19 @@ package telephony_providers_edges_trunk
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 )
 
 //generate a proxy for telephony_providers_edges_trunk
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that replaces the proxy of every client config, so tests can stub out the API calls
 var internalProxy *trunkProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[trunkProxy]
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 
 type getTrunkByIdFunc func(ctx context.Context, p *trunkProxy, id string) (*platformclientv2.Trunk, *platformclientv2.APIResponse, error)
@@ -51,14 +55,13 @@ func newTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
 	}
 }
 
-// getTeamProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTeamProxy returns the proxy of a client config, creating it on first use. It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
-	if internalProxy == nil {
-		internalProxy = newTrunkProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyCache.Get(clientConfig, newTrunkProxy)
 }
 
 func (p *trunkProxy) getEdge(ctx context.Context, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
@@ -0,0 +1,1059 @@
+package tfexporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+This file contains all of the functions used to export the config as a CDK for Terraform (CDKTF) application. Every exported resource
+and data source is written as a typed construct in TypeScript or Go. The construct classes are generated from the provider schema by
+running 'cdktf get' in the export directory, and references between resources are expressed as properties of the referenced construct.
+Constructs keep the address they have in an HCL or JSON export so the state file, import blocks and export lock still apply.
+*/
+
+const (
+	exportFormatHCL   = "hcl"
+	exportFormatJSON  = "json"
+	exportFormatCDKTF = "cdktf"
+
+	cdktfLanguageTypeScript = "typescript"
+	cdktfLanguageGo         = "go"
+
+	// Go module of the exported application. The provider bindings generated by 'cdktf get' are imported from it.
+	cdktfGoModule = "genesyscloud-export"
+
+	cdktfResourcePrefix = "genesyscloud_"
+	cdktfProviderName   = "genesyscloud"
+	cdktfStackName      = "genesyscloud"
+)
+
+// ${type.name.attr}, ${data.type.name.attr} or ${var.name}
+var cdktfReferenceRegex = regexp.MustCompile(`\$\{(data\.)?([A-Za-z0-9_-]+)\.([A-Za-z0-9_-]+)(?:\.([A-Za-z0-9_]+))?\}`)
+
+type CdktfExporter struct {
+	resourceTypesMaps   map[string]resourceJSONMaps
+	dataSourceTypesMaps map[string]resourceJSONMaps
+	unresolvedAttrs     []unresolvableAttributeInfo
+	resourceSchemas     map[string]*schema.Resource
+	dataSourceSchemas   map[string]*schema.Resource
+	providerSource      string
+	version             string
+	dirPath             string
+	language            string
+
+	// Resource address -> construct
+	constructs map[string]*cdktfConstruct
+
+	// Variable name -> variable
+	variables map[string]*cdktfVariable
+
+	identifiers map[string]bool
+
+	// Addresses of the constructs written so far. Only these can be referenced as construct properties.
+	declared map[string]bool
+
+	// Imported class or package name -> module or import path
+	imports map[string]string
+
+	// Names imported from the cdktf library by the TypeScript application
+	cdktfSymbols map[string]bool
+}
+
+type cdktfConstruct struct {
+	address      string
+	resType      string
+	name         string
+	dataSource   bool
+	config       map[string]interface{}
+	schema       map[string]*schema.Schema
+	identifier   string
+	dependencies []string
+	referenced   bool
+}
+
+type cdktfVariable struct {
+	attr       unresolvableAttributeInfo
+	identifier string
+	referenced bool
+}
+
+type cdktfReference struct {
+	construct *cdktfConstruct
+	variable  *cdktfVariable
+	attr      string
+}
+
+// cdktfSegment is a part of a string value that is either literal text or a reference
+type cdktfSegment struct {
+	text      string
+	reference *cdktfReference
+}
+
+type cdktfConfig struct {
+	Language           string                 `json:"language"`
+	App                string                 `json:"app"`
+	TerraformProviders []string               `json:"terraformProviders"`
+	TerraformModules   []string               `json:"terraformModules"`
+	CodeMakerOutput    string                 `json:"codeMakerOutput"`
+	Context            map[string]interface{} `json:"context"`
+}
+
+// NewCdktfExporter creates an exporter for a CDKTF application in the given language. The resource and data source schemas of the
+// provider are used to type the attributes of the constructs. Attributes without a schema are typed from their value.
+func NewCdktfExporter(resourceTypesMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, resourceSchemas map[string]*schema.Resource, dataSourceSchemas map[string]*schema.Resource, providerSource string, version string, dirPath string, language string) *CdktfExporter {
+	return &CdktfExporter{
+		resourceTypesMaps:   resourceTypesMaps,
+		dataSourceTypesMaps: dataSourceTypesMaps,
+		unresolvedAttrs:     unresolvedAttrs,
+		resourceSchemas:     resourceSchemas,
+		dataSourceSchemas:   dataSourceSchemas,
+		providerSource:      providerSource,
+		version:             version,
+		dirPath:             dirPath,
+		language:            language,
+	}
+}
+
+func (c *CdktfExporter) exportCdktfConfig() diag.Diagnostics {
+	c.buildConstructs()
+
+	var (
+		source     []byte
+		sourceFile string
+	)
+	if c.language == cdktfLanguageGo {
+		source = c.goSource()
+		sourceFile = defaultCdktfGoFile
+	} else {
+		source = c.typeScriptSource()
+		sourceFile = defaultCdktfTypeScriptFile
+	}
+
+	configData, err := json.MarshalIndent(c.cdktfConfig(), "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode %s: %v", defaultCdktfConfigFile, err)
+	}
+	configPath := filepath.Join(c.dirPath, defaultCdktfConfigFile)
+	log.Printf("Writing CDKTF config to %s", configPath)
+	if diagErr := files.WriteToFile(configData, configPath); diagErr != nil {
+		return diagErr
+	}
+
+	sourcePath := filepath.Join(c.dirPath, sourceFile)
+	log.Printf("Writing CDKTF application to %s", sourcePath)
+	if diagErr := files.WriteToFile(source, sourcePath); diagErr != nil {
+		return diagErr
+	}
+
+	// The tfvars file can be passed to 'cdktf deploy' with --var-file
+	return writeUnresolvedAttrsTfVars(c.unresolvedAttrs, c.dirPath)
+}
+
+func (c *CdktfExporter) cdktfConfig() cdktfConfig {
+	config := cdktfConfig{
+		Language:           c.language,
+		App:                "npx ts-node " + defaultCdktfTypeScriptFile,
+		TerraformProviders: []string{fmt.Sprintf("%s@%s", c.providerSource, c.version)},
+		TerraformModules:   []string{},
+		CodeMakerOutput:    ".gen",
+		Context:            map[string]interface{}{},
+	}
+	if c.language == cdktfLanguageGo {
+		config.App = "go run " + defaultCdktfGoFile
+		config.CodeMakerOutput = "generated"
+	}
+	return config
+}
+
+// buildConstructs assigns an identifier to every variable and construct and finds the constructs each construct depends on
+func (c *CdktfExporter) buildConstructs() {
+	c.constructs = make(map[string]*cdktfConstruct)
+	c.variables = make(map[string]*cdktfVariable)
+	c.declared = make(map[string]bool)
+	c.imports = make(map[string]string)
+	c.cdktfSymbols = make(map[string]bool)
+	c.identifiers = make(map[string]bool)
+	for _, name := range []string{"app", "cdktf", "constructs", "id", "jsii", "scope", "stack"} {
+		c.identifiers[name] = true
+	}
+
+	for _, attr := range c.unresolvedAttrs {
+		key := createUnresolvedAttrKey(attr)
+		if _, ok := c.variables[key]; !ok {
+			c.variables[key] = &cdktfVariable{attr: attr}
+		}
+	}
+	for _, key := range sortedKeys(c.variables) {
+		c.variables[key].identifier = c.newIdentifier("var_" + strings.TrimPrefix(key, cdktfResourcePrefix))
+	}
+
+	c.addConstructs(c.resourceTypesMaps, c.resourceSchemas, false)
+	c.addConstructs(c.dataSourceTypesMaps, c.dataSourceSchemas, true)
+
+	for _, construct := range c.constructs {
+		dependencies := make(map[string]bool)
+		c.collectDependencies(construct.config, dependencies)
+		delete(dependencies, construct.address)
+		construct.dependencies = sortedKeys(dependencies)
+	}
+}
+
+func (c *CdktfExporter) addConstructs(typeMaps map[string]resourceJSONMaps, schemas map[string]*schema.Resource, dataSource bool) {
+	for _, resType := range sortedKeys(typeMaps) {
+		var schemaMap map[string]*schema.Schema
+		if resource, ok := schemas[resType]; ok {
+			schemaMap = resource.SchemaMap()
+		}
+
+		for _, name := range sortedKeys(typeMaps[resType]) {
+			construct := &cdktfConstruct{
+				address:    resType + "." + name,
+				resType:    resType,
+				name:       name,
+				dataSource: dataSource,
+				config:     typeMaps[resType][name],
+				schema:     schemaMap,
+			}
+			if dataSource {
+				construct.address = "data." + construct.address
+				construct.identifier = c.newIdentifier("data_" + strings.TrimPrefix(resType, cdktfResourcePrefix) + "_" + name)
+			} else {
+				construct.identifier = c.newIdentifier(strings.TrimPrefix(resType, cdktfResourcePrefix) + "_" + name)
+			}
+			c.constructs[construct.address] = construct
+		}
+	}
+}
+
+func (c *CdktfExporter) newIdentifier(name string) string {
+	base := cdktfCamelCase(name)
+	identifier := base
+	for i := 2; c.identifiers[identifier]; i++ {
+		identifier = base + strconv.Itoa(i)
+	}
+	c.identifiers[identifier] = true
+	return identifier
+}
+
+func (c *CdktfExporter) collectDependencies(value interface{}, dependencies map[string]bool) {
+	if s, ok := value.(string); ok {
+		if strings.HasPrefix(s, "$dep$") {
+			address := strings.TrimSuffix(strings.TrimPrefix(s, "$dep$"), "$dep$")
+			if _, ok := c.constructs[address]; ok {
+				dependencies[address] = true
+			}
+			return
+		}
+		for _, parts := range cdktfReferenceRegex.FindAllStringSubmatch(s, -1) {
+			if _, ok := c.constructs[cdktfReferenceAddress(parts)]; ok {
+				dependencies[cdktfReferenceAddress(parts)] = true
+			}
+		}
+		return
+	}
+	if items, ok := cdktfList(value); ok {
+		for _, item := range items {
+			c.collectDependencies(item, dependencies)
+		}
+		return
+	}
+	if config, ok := cdktfMap(value); ok {
+		for _, item := range config {
+			c.collectDependencies(item, dependencies)
+		}
+	}
+}
+
+// constructOrder sorts the constructs so that every construct comes after the constructs it depends on. Constructs that are part of a
+// dependency cycle are written in address order and reference each other with Terraform expressions instead of construct properties.
+func (c *CdktfExporter) constructOrder() []*cdktfConstruct {
+	remaining := make(map[string]int)
+	dependents := make(map[string][]string)
+	ready := make([]string, 0)
+	for _, address := range sortedKeys(c.constructs) {
+		construct := c.constructs[address]
+		remaining[address] = len(construct.dependencies)
+		for _, dependency := range construct.dependencies {
+			dependents[dependency] = append(dependents[dependency], address)
+		}
+		if len(construct.dependencies) == 0 {
+			ready = append(ready, address)
+		}
+	}
+
+	order := make([]*cdktfConstruct, 0, len(c.constructs))
+	for len(remaining) > 0 {
+		if len(ready) == 0 {
+			address := sortedKeys(remaining)[0]
+			log.Printf("Construct %s is part of a dependency cycle", address)
+			ready = append(ready, address)
+		}
+
+		address := ready[0]
+		ready = ready[1:]
+		if _, ok := remaining[address]; !ok {
+			continue
+		}
+		delete(remaining, address)
+		order = append(order, c.constructs[address])
+
+		for _, dependent := range dependents[address] {
+			if _, ok := remaining[dependent]; !ok {
+				continue
+			}
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				i := sort.SearchStrings(ready, dependent)
+				ready = append(ready[:i], append([]string{dependent}, ready[i:]...)...)
+			}
+		}
+	}
+	return order
+}
+
+// stringSegments splits a string value into literal text and the references that can be written as properties of a variable or a
+// construct written earlier
+func (c *CdktfExporter) stringSegments(value string) []cdktfSegment {
+	segments := make([]cdktfSegment, 0)
+	last := 0
+	for _, match := range cdktfReferenceRegex.FindAllStringSubmatchIndex(value, -1) {
+		reference := c.reference(cdktfReferenceRegex.FindStringSubmatch(value[match[0]:match[1]]))
+		if reference == nil {
+			continue
+		}
+		if match[0] > last {
+			segments = append(segments, cdktfSegment{text: value[last:match[0]]})
+		}
+		segments = append(segments, cdktfSegment{reference: reference})
+		last = match[1]
+	}
+	if last < len(value) || len(segments) == 0 {
+		segments = append(segments, cdktfSegment{text: value[last:]})
+	}
+	return segments
+}
+
+func (c *CdktfExporter) reference(parts []string) *cdktfReference {
+	if parts[1] == "" && parts[2] == "var" {
+		if variable, ok := c.variables[parts[3]]; ok && parts[4] == "" {
+			variable.referenced = true
+			return &cdktfReference{variable: variable}
+		}
+		return nil
+	}
+
+	address := cdktfReferenceAddress(parts)
+	if !c.declared[address] {
+		return nil
+	}
+	construct := c.constructs[address]
+	construct.referenced = true
+	return &cdktfReference{construct: construct, attr: parts[4]}
+}
+
+// dependsOn returns the constructs written so far of a depends_on attribute
+func (c *CdktfExporter) dependsOn(value interface{}) []*cdktfConstruct {
+	items, _ := cdktfList(value)
+	constructs := make([]*cdktfConstruct, 0, len(items))
+	for _, item := range items {
+		entry, ok := item.(string)
+		if !ok {
+			continue
+		}
+		address := strings.TrimSuffix(strings.TrimPrefix(entry, "$dep$"), "$dep$")
+		if !c.declared[address] {
+			log.Printf("Dropping depends_on %s as it is not declared before the construct", address)
+			continue
+		}
+		c.constructs[address].referenced = true
+		constructs = append(constructs, c.constructs[address])
+	}
+	return constructs
+}
+
+func (c *CdktfExporter) variableDescription(attr unresolvableAttributeInfo) string {
+	if attr.Schema.Description != "" {
+		return attr.Schema.Description
+	}
+	return fmt.Sprintf("%s value for resource %s of type %s", attr.Name, attr.ResourceName, attr.ResourceType)
+}
+
+func (c *CdktfExporter) typeScriptSource() []byte {
+	const indent = "    "
+	c.cdktfSymbols["App"] = true
+	c.cdktfSymbols["TerraformStack"] = true
+	c.imports["GenesyscloudProvider"] = cdktfTypeScriptModule("provider")
+
+	constructs := c.constructOrder()
+	expressions := make([]string, len(constructs))
+	for i, construct := range constructs {
+		expressions[i] = c.typeScriptConstruct(construct, indent)
+		c.declared[construct.address] = true
+	}
+
+	statements := []string{fmt.Sprintf("%snew GenesyscloudProvider(this, %s);\n", indent, tsString(cdktfProviderName))}
+	for _, key := range sortedKeys(c.variables) {
+		variable := c.variables[key]
+		expression := c.typeScriptVariable(variable, indent)
+		if variable.referenced {
+			statements = append(statements, fmt.Sprintf("%sconst %s = %s;\n", indent, variable.identifier, expression))
+		} else {
+			statements = append(statements, fmt.Sprintf("%s%s;\n", indent, expression))
+		}
+	}
+	for i, construct := range constructs {
+		if construct.referenced {
+			statements = append(statements, fmt.Sprintf("%sconst %s = %s;\n%s%s.overrideLogicalId(%s);\n", indent, construct.identifier, expressions[i], indent, construct.identifier, tsString(construct.name)))
+		} else {
+			statements = append(statements, fmt.Sprintf("%s%s.overrideLogicalId(%s);\n", indent, expressions[i], tsString(construct.name)))
+		}
+	}
+
+	var source bytes.Buffer
+	source.WriteString("import { Construct } from \"constructs\";\n")
+	source.WriteString(fmt.Sprintf("import { %s } from \"cdktf\";\n", strings.Join(sortedKeys(c.cdktfSymbols), ", ")))
+	symbols := sortedKeys(c.imports)
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return c.imports[symbols[i]] < c.imports[symbols[j]]
+	})
+	for _, symbol := range symbols {
+		source.WriteString(fmt.Sprintf("import { %s } from %s;\n", symbol, tsString(c.imports[symbol])))
+	}
+	source.WriteString("\nclass GenesysCloudStack extends TerraformStack {\n")
+	source.WriteString("  constructor(scope: Construct, id: string) {\n")
+	source.WriteString("    super(scope, id);\n\n")
+	source.WriteString(strings.Join(statements, "\n"))
+	source.WriteString("  }\n}\n\n")
+	source.WriteString("const app = new App();\n")
+	source.WriteString(fmt.Sprintf("new GenesysCloudStack(app, %s);\n", tsString(cdktfStackName)))
+	source.WriteString("app.synth();\n")
+	return source.Bytes()
+}
+
+func (c *CdktfExporter) typeScriptConstruct(construct *cdktfConstruct, indent string) string {
+	className := cdktfClassName(construct.resType, construct.dataSource)
+	c.imports[className] = cdktfTypeScriptModule(cdktfModuleName(construct.resType, construct.dataSource))
+	return fmt.Sprintf("new %s(this, %s, {\n%s%s})", className, tsString(cdktfConstructID(construct)), c.typeScriptFields(construct.config, construct.schema, indent+"  "), indent)
+}
+
+func (c *CdktfExporter) typeScriptVariable(variable *cdktfVariable, indent string) string {
+	c.cdktfSymbols["TerraformVariable"] = true
+	attr := variable.attr
+
+	var fields strings.Builder
+	fields.WriteString(fmt.Sprintf("%s  type: %s,\n", indent, tsString(determineVarType(attr.Schema))))
+	fields.WriteString(fmt.Sprintf("%s  description: %s,\n", indent, tsString(c.variableDescription(attr))))
+	if attr.Schema.Sensitive {
+		fields.WriteString(fmt.Sprintf("%s  sensitive: true,\n", indent))
+	}
+	if attr.Schema.Default != nil {
+		fields.WriteString(fmt.Sprintf("%s  default: %s,\n", indent, c.typeScriptPrimitive(attr.Schema.Default, attr.Schema.Type)))
+	}
+	return fmt.Sprintf("new TerraformVariable(this, %s, {\n%s%s})", tsString(createUnresolvedAttrKey(attr)), fields.String(), indent)
+}
+
+func (c *CdktfExporter) typeScriptFields(config map[string]interface{}, schemaMap map[string]*schema.Schema, indent string) string {
+	var fields strings.Builder
+	for _, key := range sortedKeys(config) {
+		value := config[key]
+		if value == nil {
+			continue
+		}
+
+		if key == "depends_on" {
+			dependsOn := c.dependsOn(value)
+			if len(dependsOn) == 0 {
+				continue
+			}
+			identifiers := make([]string, len(dependsOn))
+			for i, construct := range dependsOn {
+				identifiers[i] = construct.identifier
+			}
+			fields.WriteString(fmt.Sprintf("%sdependsOn: [%s],\n", indent, strings.Join(identifiers, ", ")))
+			continue
+		}
+
+		if expression, ok := c.typeScriptValue(value, schemaMap[key], indent); ok {
+			fields.WriteString(fmt.Sprintf("%s%s: %s,\n", indent, cdktfCamelCase(key), expression))
+		}
+	}
+	return fields.String()
+}
+
+func (c *CdktfExporter) typeScriptValue(value interface{}, s *schema.Schema, indent string) (string, bool) {
+	if s == nil {
+		s = inferCdktfSchema(value)
+	}
+
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		if str, ok := value.(string); ok {
+			return c.typeScriptString(str, s.Type), true
+		}
+		items, _ := cdktfList(value)
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			objects := make([]string, 0, len(items))
+			objectIndent := indent + "  "
+			if s.MaxItems == 1 {
+				objectIndent = indent
+			}
+			for _, item := range items {
+				if config, ok := cdktfMap(item); ok {
+					objects = append(objects, fmt.Sprintf("{\n%s%s}", c.typeScriptFields(config, elem.SchemaMap(), objectIndent+"  "), objectIndent))
+				}
+			}
+			if s.MaxItems == 1 {
+				if len(objects) == 0 {
+					return "", false
+				}
+				return objects[0], true
+			}
+			if len(objects) == 0 {
+				return "[]", true
+			}
+			return fmt.Sprintf("[\n%s%s,\n%s]", objectIndent, strings.Join(objects, ",\n"+objectIndent), indent), true
+		}
+
+		elemSchema := cdktfElemSchema(s, items)
+		elements := make([]string, 0, len(items))
+		for _, item := range items {
+			if expression, ok := c.typeScriptValue(item, elemSchema, indent); ok {
+				elements = append(elements, expression)
+			}
+		}
+		return "[" + strings.Join(elements, ", ") + "]", true
+	case schema.TypeMap:
+		if str, ok := value.(string); ok {
+			return c.typeScriptString(str, s.Type), true
+		}
+		config, _ := cdktfMap(value)
+		if len(config) == 0 {
+			return "{}", true
+		}
+		elemSchema := cdktfElemSchema(s, nil)
+		var entries strings.Builder
+		for _, key := range sortedKeys(config) {
+			if expression, ok := c.typeScriptValue(config[key], elemSchema, indent+"  "); ok {
+				entries.WriteString(fmt.Sprintf("%s  %s: %s,\n", indent, tsString(key), expression))
+			}
+		}
+		return fmt.Sprintf("{\n%s%s}", entries.String(), indent), true
+	default:
+		return c.typeScriptPrimitive(value, s.Type), true
+	}
+}
+
+func (c *CdktfExporter) typeScriptPrimitive(value interface{}, valueType schema.ValueType) string {
+	if str, ok := value.(string); ok {
+		return c.typeScriptString(str, valueType)
+	}
+	literal := cdktfLiteral(value)
+	if valueType == schema.TypeString {
+		return tsString(literal)
+	}
+	return literal
+}
+
+func (c *CdktfExporter) typeScriptString(value string, valueType schema.ValueType) string {
+	segments := c.stringSegments(value)
+	if len(segments) == 1 && segments[0].reference != nil {
+		return c.typeScriptReference(segments[0].reference, valueType)
+	}
+
+	expression := tsString(value)
+	if len(segments) > 1 {
+		var template strings.Builder
+		template.WriteString("`")
+		for _, segment := range segments {
+			if segment.reference != nil {
+				template.WriteString("${" + c.typeScriptReference(segment.reference, schema.TypeString) + "}")
+				continue
+			}
+			template.WriteString(strings.NewReplacer("\\", "\\\\", "`", "\\`", "${", "\\${").Replace(segment.text))
+		}
+		template.WriteString("`")
+		expression = template.String()
+	}
+
+	switch valueType {
+	case schema.TypeInt, schema.TypeFloat:
+		c.cdktfSymbols["Token"] = true
+		return fmt.Sprintf("Token.asNumber(%s)", expression)
+	case schema.TypeBool:
+		c.cdktfSymbols["Token"] = true
+		return fmt.Sprintf("Token.asAny(%s)", expression)
+	case schema.TypeList, schema.TypeSet:
+		c.cdktfSymbols["Token"] = true
+		return fmt.Sprintf("Token.asList(%s)", expression)
+	case schema.TypeMap:
+		c.cdktfSymbols["Token"] = true
+		return fmt.Sprintf("Token.asStringMap(%s)", expression)
+	}
+	return expression
+}
+
+func (c *CdktfExporter) typeScriptReference(reference *cdktfReference, valueType schema.ValueType) string {
+	if variable := reference.variable; variable != nil {
+		switch valueType {
+		case schema.TypeInt, schema.TypeFloat:
+			return variable.identifier + ".numberValue"
+		case schema.TypeBool:
+			return variable.identifier + ".booleanValue"
+		case schema.TypeList, schema.TypeSet:
+			return variable.identifier + ".listValue"
+		case schema.TypeMap:
+			c.cdktfSymbols["Token"] = true
+			return fmt.Sprintf("Token.asStringMap(%s.value)", variable.identifier)
+		}
+		return variable.identifier + ".stringValue"
+	}
+
+	if valueType == schema.TypeString && reference.attr == "id" {
+		return reference.construct.identifier + ".id"
+	}
+	return fmt.Sprintf("%s.get%sAttribute(%s)", reference.construct.identifier, cdktfAttributeGetter(valueType), tsString(reference.attr))
+}
+
+func (c *CdktfExporter) goSource() []byte {
+	constructs := c.constructOrder()
+	expressions := make([]string, len(constructs))
+	for i, construct := range constructs {
+		expressions[i] = c.goConstruct(construct)
+		c.declared[construct.address] = true
+	}
+
+	c.imports["provider"] = cdktfGoImportPath(c.providerSource, "provider")
+	statements := []string{fmt.Sprintf("provider.NewGenesyscloudProvider(stack, jsii.String(%s), &provider.GenesyscloudProviderConfig{})\n", strconv.Quote(cdktfProviderName))}
+	for _, key := range sortedKeys(c.variables) {
+		variable := c.variables[key]
+		expression := c.goVariable(variable)
+		if variable.referenced {
+			statements = append(statements, fmt.Sprintf("%s := %s\n", variable.identifier, expression))
+		} else {
+			statements = append(statements, expression+"\n")
+		}
+	}
+	for i, construct := range constructs {
+		if construct.referenced {
+			statements = append(statements, fmt.Sprintf("%s := %s\n%s.OverrideLogicalId(jsii.String(%s))\n", construct.identifier, expressions[i], construct.identifier, strconv.Quote(construct.name)))
+		} else {
+			statements = append(statements, fmt.Sprintf("%s.OverrideLogicalId(jsii.String(%s))\n", expressions[i], strconv.Quote(construct.name)))
+		}
+	}
+
+	var source bytes.Buffer
+	source.WriteString("package main\n\nimport (\n")
+	source.WriteString("\t\"github.com/aws/constructs-go/constructs/v10\"\n")
+	source.WriteString("\t\"github.com/aws/jsii-runtime-go\"\n")
+	source.WriteString("\t\"github.com/hashicorp/terraform-cdk-go/cdktf\"\n\n")
+	packages := sortedKeys(c.imports)
+	sort.SliceStable(packages, func(i, j int) bool {
+		return c.imports[packages[i]] < c.imports[packages[j]]
+	})
+	for _, pkg := range packages {
+		source.WriteString(fmt.Sprintf("\t%s\n", strconv.Quote(c.imports[pkg])))
+	}
+	source.WriteString(")\n\n")
+	source.WriteString("func NewGenesysCloudStack(scope constructs.Construct, id string) cdktf.TerraformStack {\n")
+	source.WriteString("stack := cdktf.NewTerraformStack(scope, &id)\n\n")
+	source.WriteString(strings.Join(statements, "\n"))
+	source.WriteString("\nreturn stack\n}\n\n")
+	source.WriteString("func main() {\n")
+	source.WriteString("app := cdktf.NewApp(nil)\n")
+	source.WriteString(fmt.Sprintf("NewGenesysCloudStack(app, %s)\n", strconv.Quote(cdktfStackName)))
+	source.WriteString("app.Synth()\n}\n")
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Printf("Failed to format the CDKTF Go application: %v", err)
+		return source.Bytes()
+	}
+	return formatted
+}
+
+func (c *CdktfExporter) goConstruct(construct *cdktfConstruct) string {
+	className := cdktfClassName(construct.resType, construct.dataSource)
+	pkg := strings.ReplaceAll(cdktfModuleName(construct.resType, construct.dataSource), "-", "")
+	c.imports[pkg] = cdktfGoImportPath(c.providerSource, pkg)
+	typeName := pkg + "." + className
+	return fmt.Sprintf("%s.New%s(stack, jsii.String(%s), &%sConfig{\n%s})", pkg, className, strconv.Quote(cdktfConstructID(construct)), typeName, c.goFields(construct.config, construct.schema, typeName))
+}
+
+func (c *CdktfExporter) goVariable(variable *cdktfVariable) string {
+	attr := variable.attr
+
+	var fields strings.Builder
+	fields.WriteString(fmt.Sprintf("Type: jsii.String(%s),\n", strconv.Quote(determineVarType(attr.Schema))))
+	fields.WriteString(fmt.Sprintf("Description: jsii.String(%s),\n", strconv.Quote(c.variableDescription(attr))))
+	if attr.Schema.Sensitive {
+		fields.WriteString("Sensitive: jsii.Bool(true),\n")
+	}
+	if attr.Schema.Default != nil {
+		fields.WriteString(fmt.Sprintf("Default: %s,\n", c.goPrimitive(attr.Schema.Default, attr.Schema.Type)))
+	}
+	return fmt.Sprintf("cdktf.NewTerraformVariable(stack, jsii.String(%s), &cdktf.TerraformVariableConfig{\n%s})", strconv.Quote(createUnresolvedAttrKey(attr)), fields.String())
+}
+
+// goFields writes the fields of a config struct. Nested blocks are written as the structs cdktf generates for them, which are
+// named after the struct of the enclosing block followed by the name of the block.
+func (c *CdktfExporter) goFields(config map[string]interface{}, schemaMap map[string]*schema.Schema, typeName string) string {
+	var fields strings.Builder
+	for _, key := range sortedKeys(config) {
+		value := config[key]
+		if value == nil {
+			continue
+		}
+
+		if key == "depends_on" {
+			dependsOn := c.dependsOn(value)
+			if len(dependsOn) == 0 {
+				continue
+			}
+			identifiers := make([]string, len(dependsOn))
+			for i, construct := range dependsOn {
+				identifiers[i] = construct.identifier
+			}
+			fields.WriteString(fmt.Sprintf("DependsOn: &[]cdktf.ITerraformDependable{%s},\n", strings.Join(identifiers, ", ")))
+			continue
+		}
+
+		if expression, ok := c.goValue(value, schemaMap[key], typeName+cdktfPascalCase(key)); ok {
+			fields.WriteString(fmt.Sprintf("%s: %s,\n", cdktfPascalCase(key), expression))
+		}
+	}
+	return fields.String()
+}
+
+func (c *CdktfExporter) goValue(value interface{}, s *schema.Schema, typeName string) (string, bool) {
+	if s == nil {
+		s = inferCdktfSchema(value)
+	}
+
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		if str, ok := value.(string); ok {
+			return c.goString(str, s.Type), true
+		}
+		items, _ := cdktfList(value)
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			objects := make([]string, 0, len(items))
+			for _, item := range items {
+				if config, ok := cdktfMap(item); ok {
+					objects = append(objects, fmt.Sprintf("{\n%s}", c.goFields(config, elem.SchemaMap(), typeName)))
+				}
+			}
+			if s.MaxItems == 1 {
+				if len(objects) == 0 {
+					return "", false
+				}
+				return "&" + typeName + objects[0], true
+			}
+			if len(objects) == 0 {
+				return fmt.Sprintf("&[]*%s{}", typeName), true
+			}
+			return fmt.Sprintf("&[]*%s{\n%s,\n}", typeName, strings.Join(objects, ",\n")), true
+		}
+
+		elemSchema := cdktfElemSchema(s, items)
+		elements := make([]string, 0, len(items))
+		for _, item := range items {
+			if expression, ok := c.goValue(item, elemSchema, typeName); ok {
+				elements = append(elements, expression)
+			}
+		}
+		return fmt.Sprintf("&[]%s{%s}", cdktfGoElemType(elemSchema.Type), strings.Join(elements, ", ")), true
+	case schema.TypeMap:
+		if str, ok := value.(string); ok {
+			return c.goString(str, s.Type), true
+		}
+		config, _ := cdktfMap(value)
+		elemSchema := cdktfElemSchema(s, nil)
+		var entries strings.Builder
+		for _, key := range sortedKeys(config) {
+			if expression, ok := c.goValue(config[key], elemSchema, typeName); ok {
+				entries.WriteString(fmt.Sprintf("%s: %s,\n", strconv.Quote(key), expression))
+			}
+		}
+		if entries.Len() == 0 {
+			return fmt.Sprintf("&map[string]%s{}", cdktfGoElemType(elemSchema.Type)), true
+		}
+		return fmt.Sprintf("&map[string]%s{\n%s}", cdktfGoElemType(elemSchema.Type), entries.String()), true
+	default:
+		return c.goPrimitive(value, s.Type), true
+	}
+}
+
+func (c *CdktfExporter) goPrimitive(value interface{}, valueType schema.ValueType) string {
+	if str, ok := value.(string); ok {
+		return c.goString(str, valueType)
+	}
+	literal := cdktfLiteral(value)
+	switch valueType {
+	case schema.TypeString:
+		return fmt.Sprintf("jsii.String(%s)", strconv.Quote(literal))
+	case schema.TypeBool:
+		return fmt.Sprintf("jsii.Bool(%s)", literal)
+	}
+	return fmt.Sprintf("jsii.Number(%s)", literal)
+}
+
+func (c *CdktfExporter) goString(value string, valueType schema.ValueType) string {
+	segments := c.stringSegments(value)
+	if len(segments) == 1 && segments[0].reference != nil {
+		return c.goReference(segments[0].reference, valueType)
+	}
+
+	expression := fmt.Sprintf("jsii.String(%s)", strconv.Quote(value))
+	if len(segments) > 1 {
+		parts := make([]string, len(segments))
+		for i, segment := range segments {
+			if segment.reference != nil {
+				parts[i] = "*" + c.goReference(segment.reference, schema.TypeString)
+			} else {
+				parts[i] = strconv.Quote(segment.text)
+			}
+		}
+		expression = fmt.Sprintf("jsii.String(%s)", strings.Join(parts, " + "))
+	}
+
+	switch valueType {
+	case schema.TypeInt, schema.TypeFloat:
+		return fmt.Sprintf("cdktf.Token_AsNumber(%s)", expression)
+	case schema.TypeBool:
+		return fmt.Sprintf("cdktf.Token_AsAny(%s)", expression)
+	case schema.TypeList, schema.TypeSet:
+		return fmt.Sprintf("cdktf.Token_AsList(%s, nil)", expression)
+	case schema.TypeMap:
+		return fmt.Sprintf("cdktf.Token_AsStringMap(%s, nil)", expression)
+	}
+	return expression
+}
+
+func (c *CdktfExporter) goReference(reference *cdktfReference, valueType schema.ValueType) string {
+	if variable := reference.variable; variable != nil {
+		switch valueType {
+		case schema.TypeInt, schema.TypeFloat:
+			return variable.identifier + ".NumberValue()"
+		case schema.TypeBool:
+			return variable.identifier + ".BooleanValue()"
+		case schema.TypeList, schema.TypeSet:
+			return variable.identifier + ".ListValue()"
+		case schema.TypeMap:
+			return fmt.Sprintf("cdktf.Token_AsStringMap(%s.Value(), nil)", variable.identifier)
+		}
+		return variable.identifier + ".StringValue()"
+	}
+
+	if valueType == schema.TypeString && reference.attr == "id" {
+		return reference.construct.identifier + ".Id()"
+	}
+	return fmt.Sprintf("%s.Get%sAttribute(jsii.String(%s))", reference.construct.identifier, cdktfAttributeGetter(valueType), strconv.Quote(reference.attr))
+}
+
+// cdktfReferenceAddress returns the address of the resource or data source of a reference match. An empty address is returned for
+// variables and references without an attribute.
+func cdktfReferenceAddress(parts []string) string {
+	if parts[4] == "" || (parts[1] == "" && parts[2] == "var") {
+		return ""
+	}
+	return parts[1] + parts[2] + "." + parts[3]
+}
+
+// cdktfConstructID returns the ID of a construct in the stack. IDs have to be unique across resource types so the logical ID of the
+// construct is overridden with the resource name to keep the address of the resource.
+func cdktfConstructID(construct *cdktfConstruct) string {
+	if construct.dataSource {
+		return "data_" + construct.resType + "_" + construct.name
+	}
+	return construct.resType + "_" + construct.name
+}
+
+// cdktfClassName returns the name of the class cdktf generates for a resource or data source type
+func cdktfClassName(resType string, dataSource bool) string {
+	if dataSource {
+		return "Data" + cdktfPascalCase(resType)
+	}
+	return cdktfPascalCase(strings.TrimPrefix(resType, cdktfResourcePrefix))
+}
+
+// cdktfModuleName returns the name of the module cdktf generates for a resource or data source type
+func cdktfModuleName(resType string, dataSource bool) string {
+	if dataSource {
+		return "data-" + strings.ReplaceAll(resType, "_", "-")
+	}
+	return strings.ReplaceAll(strings.TrimPrefix(resType, cdktfResourcePrefix), "_", "-")
+}
+
+func cdktfTypeScriptModule(module string) string {
+	return "./.gen/providers/" + cdktfProviderName + "/" + module
+}
+
+// cdktfGoImportPath returns the import path of a package generated by 'cdktf get'. Packages are generated under the namespace and
+// name of the provider source.
+func cdktfGoImportPath(providerSource string, pkg string) string {
+	sourceParts := strings.Split(providerSource, "/")
+	if len(sourceParts) > 2 {
+		sourceParts = sourceParts[len(sourceParts)-2:]
+	}
+	return strings.Join(append([]string{cdktfGoModule, "generated"}, append(sourceParts, pkg)...), "/")
+}
+
+func cdktfAttributeGetter(valueType schema.ValueType) string {
+	switch valueType {
+	case schema.TypeInt, schema.TypeFloat:
+		return "Number"
+	case schema.TypeBool:
+		return "Boolean"
+	case schema.TypeList, schema.TypeSet:
+		return "List"
+	case schema.TypeMap:
+		return "StringMap"
+	}
+	return "String"
+}
+
+func cdktfGoElemType(valueType schema.ValueType) string {
+	switch valueType {
+	case schema.TypeInt, schema.TypeFloat:
+		return "*float64"
+	case schema.TypeBool:
+		return "interface{}"
+	}
+	return "*string"
+}
+
+func cdktfWords(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func cdktfPascalCase(name string) string {
+	var result strings.Builder
+	for _, word := range cdktfWords(name) {
+		runes := []rune(word)
+		result.WriteString(strings.ToUpper(string(runes[0])) + string(runes[1:]))
+	}
+	return result.String()
+}
+
+func cdktfCamelCase(name string) string {
+	pascal := []rune(cdktfPascalCase(name))
+	if len(pascal) == 0 {
+		return ""
+	}
+	return strings.ToLower(string(pascal[0])) + string(pascal[1:])
+}
+
+// tsString returns a TypeScript string literal. JSON strings are valid TypeScript string literals.
+func tsString(s string) string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func cdktfLiteral(value interface{}) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+func cdktfList(value interface{}) ([]interface{}, bool) {
+	switch v := value.(type) {
+	case []interface{}:
+		return v, true
+	case []string:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return items, true
+	case []util.JsonMap:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return items, true
+	}
+	return nil, false
+}
+
+func cdktfMap(value interface{}) (map[string]interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, true
+	case util.JsonMap:
+		return v, true
+	}
+	return nil, false
+}
+
+func cdktfElemSchema(s *schema.Schema, items []interface{}) *schema.Schema {
+	if elem, ok := s.Elem.(*schema.Schema); ok {
+		return elem
+	}
+	if len(items) > 0 {
+		return inferCdktfSchema(items[0])
+	}
+	return &schema.Schema{Type: schema.TypeString}
+}
+
+// inferCdktfSchema types an attribute that is not in the provider schema from its value
+func inferCdktfSchema(value interface{}) *schema.Schema {
+	switch value.(type) {
+	case bool:
+		return &schema.Schema{Type: schema.TypeBool}
+	case float64, float32, int, int32, int64:
+		return &schema.Schema{Type: schema.TypeFloat}
+	}
+
+	if config, ok := cdktfMap(value); ok {
+		return &schema.Schema{Type: schema.TypeMap, Elem: cdktfElemSchema(&schema.Schema{}, mapValues(config))}
+	}
+
+	items, ok := cdktfList(value)
+	if !ok {
+		return &schema.Schema{Type: schema.TypeString}
+	}
+	if len(items) > 0 {
+		if _, ok := cdktfMap(items[0]); ok {
+			elem := &schema.Resource{Schema: make(map[string]*schema.Schema)}
+			for _, item := range items {
+				config, _ := cdktfMap(item)
+				for key, attrValue := range config {
+					if _, ok := elem.Schema[key]; !ok && attrValue != nil {
+						elem.Schema[key] = inferCdktfSchema(attrValue)
+					}
+				}
+			}
+			return &schema.Schema{Type: schema.TypeList, Elem: elem}
+		}
+	}
+	return &schema.Schema{Type: schema.TypeList, Elem: cdktfElemSchema(&schema.Schema{}, items)}
+}
+
+func mapValues(m map[string]interface{}) []interface{} {
+	values := make([]interface{}, 0, len(m))
+	for _, key := range sortedKeys(m) {
+		values = append(values, m[key])
+	}
+	return values
+}
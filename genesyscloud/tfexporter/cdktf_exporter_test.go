@@ -0,0 +1,132 @@
+package tfexporter
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportCdktf(t *testing.T) {
+	resourceSchemas := map[string]*schema.Resource{
+		"genesyscloud_routing_queue": {
+			Schema: map[string]*schema.Schema{
+				"name":           {Type: schema.TypeString, Required: true},
+				"division_id":    {Type: schema.TypeString, Optional: true},
+				"acw_timeout_ms": {Type: schema.TypeInt, Optional: true},
+				"wrapup_codes":   {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"media_settings_call": {Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alerting_timeout_sec": {Type: schema.TypeInt, Optional: true},
+					},
+				}},
+				"bullseye_rings": {Type: schema.TypeList, Optional: true, Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expansion_timeout_seconds": {Type: schema.TypeFloat, Optional: true},
+					},
+				}},
+			},
+		},
+		"genesyscloud_routing_wrapupcode": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+			},
+		},
+	}
+
+	resourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_routing_queue": {
+			"Support": util.JsonMap{
+				"name":                "Support ${var.genesyscloud_routing_queue_Support_name}",
+				"division_id":         "${data.genesyscloud_auth_division_home.Home.id}",
+				"acw_timeout_ms":      float64(300000),
+				"wrapup_codes":        []interface{}{"${genesyscloud_routing_wrapupcode.Billing.id}"},
+				"media_settings_call": []interface{}{map[string]interface{}{"alerting_timeout_sec": float64(8)}},
+				"bullseye_rings":      []interface{}{map[string]interface{}{"expansion_timeout_seconds": float64(15.5)}},
+				"depends_on":          []string{"$dep$genesyscloud_routing_wrapupcode.Billing$dep$"},
+			},
+		},
+		"genesyscloud_routing_wrapupcode": {
+			"Billing": util.JsonMap{"name": "Billing"},
+			"Unused":  util.JsonMap{"name": "Unused"},
+		},
+	}
+	dataSourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_auth_division_home": {
+			"Home": util.JsonMap{},
+		},
+	}
+	unresolvedAttrs := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_routing_queue", ResourceName: "Support", Name: "name", Schema: &schema.Schema{Type: schema.TypeString}},
+	}
+
+	exportDir := t.TempDir()
+	typeScript := NewCdktfExporter(resourceTypesMaps, dataSourceTypesMaps, unresolvedAttrs, resourceSchemas, nil, "registry.terraform.io/mypurecloud/genesyscloud", "1.0.0", exportDir, cdktfLanguageTypeScript)
+	assert.Nil(t, typeScript.exportCdktfConfig())
+
+	// Referenced constructs are declared before the constructs that reference them
+	order := make([]string, 0)
+	for _, construct := range typeScript.constructOrder() {
+		order = append(order, construct.address)
+	}
+	assert.Equal(t, []string{"data.genesyscloud_auth_division_home.Home", "genesyscloud_routing_wrapupcode.Billing", "genesyscloud_routing_queue.Support", "genesyscloud_routing_wrapupcode.Unused"}, order)
+
+	source, err := os.ReadFile(filepath.Join(exportDir, defaultCdktfTypeScriptFile))
+	assert.Nil(t, err)
+	assert.Contains(t, string(source), `import { App, TerraformStack, TerraformVariable } from "cdktf";`)
+	assert.Contains(t, string(source), `import { RoutingQueue } from "./.gen/providers/genesyscloud/routing-queue";`)
+	assert.Contains(t, string(source), `import { DataGenesyscloudAuthDivisionHome } from "./.gen/providers/genesyscloud/data-genesyscloud-auth-division-home";`)
+	assert.Contains(t, string(source), `const routingWrapupcodeBilling = new RoutingWrapupcode(this, "genesyscloud_routing_wrapupcode_Billing", {`)
+	assert.Contains(t, string(source), `routingWrapupcodeBilling.overrideLogicalId("Billing");`)
+	assert.Contains(t, string(source), `new RoutingWrapupcode(this, "genesyscloud_routing_wrapupcode_Unused", {`)
+	assert.Contains(t, string(source), `      acwTimeoutMs: 300000,`)
+	assert.Contains(t, string(source), `      divisionId: dataAuthDivisionHomeHome.id,`)
+	assert.Contains(t, string(source), "      name: `Support ${varRoutingQueueSupportName.stringValue}`,")
+	assert.Contains(t, string(source), `      wrapupCodes: [routingWrapupcodeBilling.id],`)
+	assert.Contains(t, string(source), `      dependsOn: [routingWrapupcodeBilling],`)
+	assert.Contains(t, string(source), "      mediaSettingsCall: {\n        alertingTimeoutSec: 8,\n      },")
+	assert.Contains(t, string(source), "      bullseyeRings: [\n        {\n          expansionTimeoutSeconds: 15.5,\n        },\n      ],")
+
+	config, err := os.ReadFile(filepath.Join(exportDir, defaultCdktfConfigFile))
+	assert.Nil(t, err)
+	assert.Contains(t, string(config), `"registry.terraform.io/mypurecloud/genesyscloud@1.0.0"`)
+
+	// The Go application is valid Go source with the typed config structs of the constructs
+	goExporter := NewCdktfExporter(resourceTypesMaps, dataSourceTypesMaps, unresolvedAttrs, resourceSchemas, nil, "registry.terraform.io/mypurecloud/genesyscloud", "1.0.0", exportDir, cdktfLanguageGo)
+	assert.Nil(t, goExporter.exportCdktfConfig())
+
+	source, err = os.ReadFile(filepath.Join(exportDir, defaultCdktfGoFile))
+	assert.Nil(t, err)
+	_, err = parser.ParseFile(token.NewFileSet(), defaultCdktfGoFile, source, parser.AllErrors)
+	assert.Nil(t, err)
+	assert.Contains(t, string(source), `"genesyscloud-export/generated/mypurecloud/genesyscloud/routingqueue"`)
+	assert.Contains(t, string(source), `routingqueue.NewRoutingQueue(stack, jsii.String("genesyscloud_routing_queue_Support"), &routingqueue.RoutingQueueConfig{`)
+	assert.Contains(t, string(source), `DivisionId: dataAuthDivisionHomeHome.Id(),`)
+	assert.Contains(t, string(source), `Name:        jsii.String("Support " + *varRoutingQueueSupportName.StringValue()),`)
+	assert.Contains(t, string(source), `WrapupCodes: &[]*string{routingWrapupcodeBilling.Id()},`)
+	assert.Contains(t, string(source), `MediaSettingsCall: &routingqueue.RoutingQueueMediaSettingsCall{`)
+	assert.Contains(t, string(source), `}).OverrideLogicalId(jsii.String("Support"))`)
+	assert.Contains(t, string(source), `BullseyeRings: &[]*routingqueue.RoutingQueueBullseyeRings{`)
+	assert.Contains(t, string(source), `DependsOn:  &[]cdktf.ITerraformDependable{routingWrapupcodeBilling},`)
+}
+
+func TestUnitTfExportCdktfUnsupportedSettings(t *testing.T) {
+	planExport := func(config map[string]interface{}) error {
+		_, err := ResourceTfExport().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	// Module layouts and secret references are rejected at plan time for CDK for Terraform applications only
+	assert.Error(t, planExport(map[string]interface{}{"export_format": exportFormatCDKTF, "module_layout": moduleLayoutDomain}))
+	assert.NoError(t, planExport(map[string]interface{}{"export_format": exportFormatHCL, "module_layout": moduleLayoutDomain}))
+	assert.Error(t, planExport(map[string]interface{}{"export_format": exportFormatCDKTF, "secret_reference_style": secretReferenceStyleVault}))
+	assert.NoError(t, planExport(map[string]interface{}{"export_format": exportFormatHCL, "secret_reference_style": secretReferenceStyleVault}))
+	assert.NoError(t, planExport(map[string]interface{}{"export_format": exportFormatCDKTF}))
+}
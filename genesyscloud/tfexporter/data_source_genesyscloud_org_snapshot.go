@@ -0,0 +1,195 @@
+package tfexporter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	gcloud "terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	rRegistrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+This file contains the genesyscloud_org_snapshot data source. The data source reads the objects of an org with the same enumeration,
+filters and sanitization as an export, but returns the exported config of every object to Terraform instead of writing any files.
+This allows an org to be inspected from a Terraform config, for example to count the users without a location.
+*/
+
+func DataSourceOrgSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for a snapshot of a Genesys Cloud org. Reads the objects of the exportable resource types as an export would, without writing any files. The config of the objects is returned as a JSON string per resource type, since every resource type has its own schema and a Terraform attribute can only have one type. Use `jsondecode` to read the objects.",
+		ReadContext: dataSourceOrgSnapshotRead,
+		Schema: map[string]*schema.Schema{
+			"include_filter_resources": {
+				Description: "Include resources that match either a resource type or a resource type::regular expression. Defaults to all exportable types. See export guide for additional information",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: gcloud.ValidateSubStringInSlice(resourceExporter.GetAvailableExporterTypes()),
+				},
+				ConflictsWith: []string{"exclude_filter_resources"},
+			},
+			"exclude_filter_resources": {
+				Description: "Exclude resources that match either a resource type or a resource type::regular expression.  See export guide for additional information",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: gcloud.ValidateSubStringInSlice(resourceExporter.GetAvailableExporterTypes()),
+				},
+				ConflictsWith: []string{"include_filter_resources"},
+			},
+			"exclude_attributes": {
+				Description: "Attributes to exclude from the snapshot. Each value should be of the form {resource_name}.{attribute}, e.g. 'genesyscloud_user.skills'. Excluded attributes must be optional.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"log_permission_errors": {
+				Description: "Log permission/product issues rather than fail. Resource types that cannot be read are left out of the snapshot.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"resources": {
+				Description: "The objects of every resource type in the snapshot, keyed by resource type. Each value is a JSON object that maps the exported resource name of an object to its config, including its `id`. References to other objects in the snapshot are written as reference expressions, e.g. `${genesyscloud_auth_division.Home.id}`. GUIDs of objects that are not in the snapshot are kept as they are.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_counts": {
+				Description: "The number of objects of every resource type in the snapshot, keyed by resource type.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceOrgSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filterType := LegacyInclude
+	if _, ok := d.GetOk("include_filter_resources"); ok {
+		filterType = IncludeResources
+	}
+	if _, ok := d.GetOk("exclude_filter_resources"); ok {
+		filterType = ExcludeResources
+	}
+
+	gre := newOrgSnapshotExporter(ctx, d, meta, filterType)
+	snapshot, diagErr := gre.Snapshot()
+	if diagErr != nil {
+		return diagErr
+	}
+
+	resources := make(map[string]interface{}, len(snapshot))
+	resourceCounts := make(map[string]interface{}, len(snapshot))
+	for resType, objects := range snapshot {
+		data, err := json.Marshal(objects)
+		if err != nil {
+			return diag.Errorf("failed to encode the snapshot of %s as JSON: %v", resType, err)
+		}
+		resources[resType] = string(data)
+		resourceCounts[resType] = len(objects)
+	}
+
+	// The ID changes whenever any object in the snapshot changes
+	hash := sha256.New()
+	for _, resType := range sortedKeys(resources) {
+		hash.Write([]byte(resType))
+		hash.Write([]byte(resources[resType].(string)))
+	}
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+
+	if err := d.Set("resources", resources); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("resource_counts", resourceCounts); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// newOrgSnapshotExporter creates an exporter that only reads and sanitizes the objects of an org. None of the export options that
+// write files are set.
+func newOrgSnapshotExporter(ctx context.Context, d *schema.ResourceData, meta interface{}, filterType ExporterFilterType) *GenesysCloudResourceExporter {
+	if providerResources == nil {
+		providerResources, providerDataSources = rRegistrar.GetResources()
+	}
+
+	gre := &GenesysCloudResourceExporter{
+		logPermissionErrors: d.Get("log_permission_errors").(bool),
+		filterType:          filterType,
+		keepUnexportedRefs:  true,
+		snapshot:            true,
+		version:             meta.(*provider.ProviderMeta).Version,
+		provider:            provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
+		d:                   d,
+		ctx:                 provider.ContextWithClientPool(ctx, meta.(*provider.ProviderMeta).ClientPool),
+		meta:                meta,
+	}
+
+	configureExporterType(ctx, d, gre, filterType)
+	return gre
+}
+
+// Snapshot reads and sanitizes the objects to export and returns their config keyed by resource type and resource name. The ID of
+// every object is kept in its config.
+func (g *GenesysCloudResourceExporter) Snapshot() (map[string]resourceJSONMaps, diag.Diagnostics) {
+	diagErr := g.retrieveExporters()
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	diagErr = g.retrieveSanitizedResourceMaps()
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	diagErr = g.retrieveGenesysCloudObjectInstances()
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	diagErr = g.buildResourceConfigMap()
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	return g.snapshotObjects(), nil
+}
+
+// snapshotObjects returns the sanitized config of every object along with its ID
+func (g *GenesysCloudResourceExporter) snapshotObjects() map[string]resourceJSONMaps {
+	snapshot := make(map[string]resourceJSONMaps)
+	for _, resource := range g.resources {
+		config, ok := g.resourceTypesMaps[resource.Type][resource.Name]
+		if !ok {
+			continue
+		}
+		if snapshot[resource.Type] == nil {
+			snapshot[resource.Type] = make(resourceJSONMaps)
+		}
+
+		object := make(util.JsonMap, len(config)+1)
+		for attr, value := range config {
+			if value != nil {
+				object[attr] = value
+			}
+		}
+		object["id"] = resource.State.ID
+		snapshot[resource.Type][resource.Name] = object
+	}
+
+	log.Printf("Read a snapshot of %d objects of %d resource types", len(g.resources), len(snapshot))
+	return snapshot
+}
@@ -0,0 +1,89 @@
+package tfexporter
+
+import (
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportOrgSnapshot(t *testing.T) {
+	queueResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":              {Type: schema.TypeString},
+			"division_id":       {Type: schema.TypeString},
+			"default_script_id": {Type: schema.TypeString},
+			"description":       {Type: schema.TypeString},
+		},
+	}
+	divisionResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString},
+		},
+	}
+
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_routing_queue": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"division_id":       {RefType: "genesyscloud_auth_division"},
+				"default_script_id": {RefType: "genesyscloud_script"},
+			},
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{"queue-1": {Name: "Support"}},
+			CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+				RetrieveAndWriteFilesFunc: func(string, string, string, map[string]interface{}, interface{}) error {
+					t.Error("a snapshot must not write files")
+					return nil
+				},
+			},
+		},
+		"genesyscloud_auth_division": {
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{"division-1": {Name: "Home"}},
+		},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		keepUnexportedRefs: true,
+		snapshot:           true,
+		exporters:          &exporters,
+		resources: []resourceExporter.ResourceInfo{
+			{
+				Name: "Support",
+				Type: "genesyscloud_routing_queue",
+				State: &terraform.InstanceState{
+					ID: "queue-1",
+					Attributes: map[string]string{
+						"name":              "Support",
+						"division_id":       "division-1",
+						"default_script_id": "script-1",
+					},
+				},
+				CtyType: queueResource.CoreConfigSchema().ImpliedType(),
+			},
+			{
+				Name: "Home",
+				Type: "genesyscloud_auth_division",
+				State: &terraform.InstanceState{
+					ID:         "division-1",
+					Attributes: map[string]string{"name": "Home"},
+				},
+				CtyType: divisionResource.CoreConfigSchema().ImpliedType(),
+			},
+		},
+	}
+
+	assert.Nil(t, g.buildResourceConfigMap())
+	snapshot := g.snapshotObjects()
+
+	// Objects keep their ID, references to objects in the snapshot are resolved and other GUIDs are kept
+	assert.Equal(t, util.JsonMap{
+		"id":                "queue-1",
+		"name":              "Support",
+		"division_id":       "${genesyscloud_auth_division.Home.id}",
+		"default_script_id": "script-1",
+	}, snapshot["genesyscloud_routing_queue"]["Support"])
+	assert.Equal(t, util.JsonMap{"id": "division-1", "name": "Home"}, snapshot["genesyscloud_auth_division"]["Home"])
+}
@@ -1,5 +1,12 @@
 package tfexporter
 
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
 var DataSourceExports []string
 
 func SetDataSourceExports() []string {
@@ -8,3 +15,48 @@ func SetDataSourceExports() []string {
 	}
 	return DataSourceExports
 }
+
+// filterDataSourcePatterns removes the empty replace_with_datasource patterns and the patterns of resource types that do not have a
+// registered data source. Objects of those types are exported as resources.
+func filterDataSourcePatterns(patterns []string, dataSources map[string]*schema.Resource) []string {
+	result := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		resType := strings.Split(pattern, "::")[0]
+		if _, ok := dataSources[resType]; !ok {
+			log.Printf("Resource type %s does not have a data source. Ignoring replace_with_datasource pattern %s", resType, pattern)
+			continue
+		}
+		result = append(result, pattern)
+	}
+	return result
+}
+
+// dataSourceAttributes returns the attributes of the data block that replaces an exported object. When the data source looks objects
+// up by name, the data block is keyed on the name of the object along with any other attributes the data source requires. Otherwise
+// every attribute of the object that is also an attribute of the data source is used.
+func dataSourceAttributes(dataSource *schema.Resource, attributes map[string]string) map[string]string {
+	schemaMap := dataSource.SchemaMap()
+	result := make(map[string]string)
+
+	if _, ok := schemaMap["name"]; ok && attributes["name"] != "" {
+		result["name"] = attributes["name"]
+		for attr, attrSchema := range schemaMap {
+			if attrSchema.Required {
+				if value, ok := attributes[attr]; ok {
+					result[attr] = value
+				}
+			}
+		}
+		return result
+	}
+
+	for attr := range schemaMap {
+		if value, ok := attributes[attr]; ok {
+			result[attr] = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package tfexporter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportReplaceWithDataSource(t *testing.T) {
+	dataSources := map[string]*schema.Resource{
+		"genesyscloud_auth_division": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+			},
+		},
+		"genesyscloud_routing_skill": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+			},
+		},
+		"genesyscloud_user": {
+			Schema: map[string]*schema.Schema{
+				"email": {Type: schema.TypeString, Optional: true},
+				"name":  {Type: schema.TypeString, Optional: true},
+			},
+		},
+		"genesyscloud_location": {
+			Schema: map[string]*schema.Schema{
+				"search_text": {Type: schema.TypeString, Optional: true},
+				"address":     {Type: schema.TypeString, Optional: true},
+			},
+		},
+	}
+
+	// Empty patterns and patterns of types without a data source are ignored
+	patterns := filterDataSourcePatterns([]string{"", "genesyscloud_auth_division", "genesyscloud_routing_skill::^Shared_", "genesyscloud_routing_queue::.*"}, dataSources)
+	assert.Equal(t, []string{"genesyscloud_auth_division", "genesyscloud_routing_skill::^Shared_"}, patterns)
+
+	g := &GenesysCloudResourceExporter{replaceWithDatasource: patterns}
+	assert.True(t, g.isDataSource("genesyscloud_auth_division", "Home"))
+	assert.True(t, g.isDataSource("genesyscloud_routing_skill", "Shared_Spanish"))
+	assert.False(t, g.isDataSource("genesyscloud_routing_skill", "Billing"))
+	assert.False(t, g.isDataSource("genesyscloud_routing_queue", "Support"))
+
+	// Data blocks are keyed on the name when the data source supports it
+	attributes := map[string]string{"id": "user-1", "name": "Jane Doe", "email": "jane@example.com", "division_id": "division-1"}
+	assert.Equal(t, map[string]string{"name": "Jane Doe"}, dataSourceAttributes(dataSources["genesyscloud_user"], attributes))
+	assert.Equal(t, map[string]string{"name": "Home"}, dataSourceAttributes(dataSources["genesyscloud_auth_division"], map[string]string{"name": "Home", "description": "Home division"}))
+
+	// Otherwise every attribute shared with the data source is used
+	assert.Equal(t, map[string]string{"address": "1 Main St"}, dataSourceAttributes(dataSources["genesyscloud_location"], map[string]string{"name": "HQ", "address": "1 Main St"}))
+}
@@ -0,0 +1,304 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the logic used to write the dependency graph of an export. Every exported resource is a node, and an edge is added
+for every reference attribute (RefAttrs and EncodedRefAttrs) that points to another exported resource and for every depends_on entry
+found by the dependency resolution. Edges that are part of a cycle are flagged so that they can be highlighted. The graph is written
+as both a Graphviz DOT file and a JSON file.
+*/
+
+const (
+	dependencyEdgeReference = "reference"
+	dependencyEdgeDependsOn = "depends_on"
+)
+
+type dependencyGraph struct {
+	Nodes []dependencyGraphNode `json:"nodes"`
+	Edges []dependencyGraphEdge `json:"edges"`
+}
+
+type dependencyGraphNode struct {
+	Address    string `json:"address"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Id         string `json:"id"`
+	DataSource bool   `json:"data_source"`
+}
+
+type dependencyGraphEdge struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Kind      string `json:"kind"`
+	Attribute string `json:"attribute,omitempty"`
+	Cyclic    bool   `json:"cyclic"`
+}
+
+// resourceReference is a reference from an attribute of an object to another object
+type resourceReference struct {
+	Attribute string
+	RefType   string
+	RefId     string
+}
+
+// resourceReferences returns the references found in the state of an object using the RefAttrs and EncodedRefAttrs of its exporter
+func resourceReferences(exporter *resourceExporter.ResourceExporter, state *terraform.InstanceState) []resourceReference {
+	references := make([]resourceReference, 0)
+	if exporter == nil || state == nil {
+		return references
+	}
+
+	for key, value := range state.Attributes {
+		path := attributePathWithoutIndexes(key)
+		if path == "" || value == "" {
+			continue
+		}
+		refSettings := exporter.GetRefAttrSettings(path)
+		if refSettings == nil {
+			if idx := strings.LastIndex(path, "."); idx > 0 {
+				refSettings = exporter.GetRefAttrSettings(path[:idx] + ".*")
+			}
+		}
+		if refSettings == nil || refSettings.RefType == "" || lists.ItemInSlice(value, refSettings.AltValues) {
+			continue
+		}
+		references = append(references, resourceReference{Attribute: path, RefType: refSettings.RefType, RefId: value})
+	}
+
+	for encodedAttr, refSettings := range exporter.EncodedRefAttrs {
+		if encodedAttr == nil || refSettings == nil {
+			continue
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(state.Attributes[encodedAttr.Attr]), &data); err != nil {
+			continue
+		}
+		var values []interface{}
+		switch v := data[encodedAttr.NestedAttr].(type) {
+		case string:
+			values = []interface{}{v}
+		case []interface{}:
+			values = v
+		}
+		for _, value := range values {
+			if id, ok := value.(string); ok && id != "" && !lists.ItemInSlice(id, refSettings.AltValues) {
+				references = append(references, resourceReference{Attribute: encodedAttr.Attr + "." + encodedAttr.NestedAttr, RefType: refSettings.RefType, RefId: id})
+			}
+		}
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].Attribute != references[j].Attribute {
+			return references[i].Attribute < references[j].Attribute
+		}
+		return references[i].RefId < references[j].RefId
+	})
+	return references
+}
+
+// buildDependencyGraph builds the graph of the exported resources
+func (g *GenesysCloudResourceExporter) buildDependencyGraph() *dependencyGraph {
+	graph := &dependencyGraph{
+		Nodes: make([]dependencyGraphNode, 0, len(g.resources)),
+		Edges: make([]dependencyGraphEdge, 0),
+	}
+
+	// Resource type -> object ID -> address
+	addresses := make(map[string]map[string]string)
+	for _, resource := range g.resources {
+		if resource.State == nil {
+			continue
+		}
+		node := dependencyGraphNode{
+			Address:    resource.Type + "." + resource.Name,
+			Type:       resource.Type,
+			Name:       resource.Name,
+			Id:         resource.State.ID,
+			DataSource: g.isDataSource(resource.Type, resource.Name),
+		}
+		if node.DataSource {
+			node.Address = "data." + node.Address
+		}
+		if addresses[resource.Type] == nil {
+			addresses[resource.Type] = make(map[string]string)
+		}
+		addresses[resource.Type][resource.State.ID] = node.Address
+		graph.Nodes = append(graph.Nodes, node)
+	}
+
+	var exporters map[string]*resourceExporter.ResourceExporter
+	if g.exporters != nil {
+		exporters = *g.exporters
+	}
+
+	seen := make(map[dependencyGraphEdge]bool)
+	addEdge := func(edge dependencyGraphEdge) {
+		if edge.From == edge.To || seen[edge] {
+			return
+		}
+		seen[edge] = true
+		graph.Edges = append(graph.Edges, edge)
+	}
+
+	for _, resource := range g.resources {
+		if resource.State == nil {
+			continue
+		}
+		from := addresses[resource.Type][resource.State.ID]
+		for _, reference := range resourceReferences(exporters[resource.Type], resource.State) {
+			if to, ok := addresses[reference.RefType][reference.RefId]; ok {
+				addEdge(dependencyGraphEdge{From: from, To: to, Kind: dependencyEdgeReference, Attribute: reference.Attribute})
+			}
+		}
+
+		// depends_on entries are recorded as <type>.<id>
+		for _, dependency := range g.dependsList[resource.State.ID] {
+			parts := strings.SplitN(dependency, ".", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			if to, ok := addresses[parts[0]][parts[1]]; ok {
+				addEdge(dependencyGraphEdge{From: from, To: to, Kind: dependencyEdgeDependsOn})
+			}
+		}
+	}
+
+	markCyclicEdges(graph)
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Address < graph.Nodes[j].Address
+	})
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Attribute < b.Attribute
+	})
+	return graph
+}
+
+// markCyclicEdges flags the edges between nodes of the same strongly connected component, i.e. the edges that are part of a cycle
+func markCyclicEdges(graph *dependencyGraph) {
+	adjacent := make(map[string][]string)
+	for _, edge := range graph.Edges {
+		adjacent[edge.From] = append(adjacent[edge.From], edge.To)
+	}
+
+	// Tarjan's strongly connected components algorithm
+	var (
+		index     int
+		stack     []string
+		onStack   = make(map[string]bool)
+		indexes   = make(map[string]int)
+		lowLinks  = make(map[string]int)
+		component = make(map[string]int)
+		count     int
+	)
+	var connect func(node string)
+	connect = func(node string) {
+		indexes[node] = index
+		lowLinks[node] = index
+		index++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, next := range adjacent[node] {
+			if _, visited := indexes[next]; !visited {
+				connect(next)
+				if lowLinks[next] < lowLinks[node] {
+					lowLinks[node] = lowLinks[next]
+				}
+			} else if onStack[next] && indexes[next] < lowLinks[node] {
+				lowLinks[node] = indexes[next]
+			}
+		}
+
+		if lowLinks[node] == indexes[node] {
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component[top] = count
+				if top == node {
+					break
+				}
+			}
+			count++
+		}
+	}
+
+	for _, node := range graph.Nodes {
+		if _, visited := indexes[node.Address]; !visited {
+			connect(node.Address)
+		}
+	}
+
+	for i, edge := range graph.Edges {
+		graph.Edges[i].Cyclic = component[edge.From] == component[edge.To]
+	}
+}
+
+// dot renders the graph in the Graphviz DOT format. Edges that are part of a cycle are drawn in red.
+func (graph *dependencyGraph) dot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph dependencies {\n")
+	sb.WriteString("  rankdir = \"LR\";\n")
+	sb.WriteString("  node [shape = \"box\"];\n")
+	for _, node := range graph.Nodes {
+		style := ""
+		if node.DataSource {
+			style = ", style = \"dashed\""
+		}
+		sb.WriteString(fmt.Sprintf("  %q [label = %q%s];\n", node.Address, node.Address, style))
+	}
+	for _, edge := range graph.Edges {
+		label := edge.Attribute
+		if edge.Kind == dependencyEdgeDependsOn {
+			label = dependencyEdgeDependsOn
+		}
+		attributes := []string{fmt.Sprintf("label = %q", label)}
+		if edge.Kind == dependencyEdgeDependsOn {
+			attributes = append(attributes, "style = \"dotted\"")
+		}
+		if edge.Cyclic {
+			attributes = append(attributes, "color = \"red\"", "penwidth = 2")
+		}
+		sb.WriteString(fmt.Sprintf("  %q -> %q [%s];\n", edge.From, edge.To, strings.Join(attributes, ", ")))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// writeDependencyGraph writes the dependency graph of the exported resources to the export directory
+func (g *GenesysCloudResourceExporter) writeDependencyGraph() diag.Diagnostics {
+	graph := g.buildDependencyGraph()
+
+	data, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode dependency graph as JSON: %v", err)
+	}
+	if diagErr := files.WriteToFile(data, filepath.Join(g.exportDirPath, defaultDependenciesJSONFile)); diagErr != nil {
+		return diagErr
+	}
+	return files.WriteToFile([]byte(graph.dot()), filepath.Join(g.exportDirPath, defaultDependenciesDOTFile))
+}
@@ -0,0 +1,86 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportDependencyGraph(t *testing.T) {
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_routing_queue": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"division_id":       {RefType: "genesyscloud_auth_division"},
+				"members.user_id":   {RefType: "genesyscloud_user"},
+				"default_script_id": {RefType: "genesyscloud_script", AltValues: []string{"none"}},
+			},
+		},
+		"genesyscloud_user": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"division_id": {RefType: "genesyscloud_auth_division"},
+			},
+		},
+		"genesyscloud_flow": {
+			EncodedRefAttrs: map[*resourceExporter.JsonEncodeRefAttr]*resourceExporter.RefAttrSettings{
+				{Attr: "config", NestedAttr: "queueIds"}: {RefType: "genesyscloud_routing_queue"},
+			},
+		},
+		"genesyscloud_auth_division": {},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		exportDirPath:         t.TempDir(),
+		exporters:             &exporters,
+		replaceWithDatasource: []string{"genesyscloud_auth_division::home"},
+		resources: []resourceExporter.ResourceInfo{
+			{Name: "home", Type: "genesyscloud_auth_division", State: &terraform.InstanceState{ID: "division-1"}},
+			{Name: "user_1", Type: "genesyscloud_user", State: &terraform.InstanceState{ID: "user-1", Attributes: map[string]string{"division_id": "division-1"}}},
+			{Name: "queue_1", Type: "genesyscloud_routing_queue", State: &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{
+				"division_id":       "division-1",
+				"members.#":         "2",
+				"members.0.user_id": "user-1",
+				"members.1.user_id": "user-2",
+				"default_script_id": "none",
+			}}},
+			{Name: "flow_a", Type: "genesyscloud_flow", State: &terraform.InstanceState{ID: "flow-a", Attributes: map[string]string{"config": `{"queueIds":["queue-1"]}`}}},
+			{Name: "flow_b", Type: "genesyscloud_flow", State: &terraform.InstanceState{ID: "flow-b"}},
+		},
+		dependsList: map[string][]string{
+			"flow-a": {"genesyscloud_flow.flow-b"},
+			"flow-b": {"genesyscloud_flow.flow-a"},
+		},
+	}
+
+	graph := g.buildDependencyGraph()
+	assert.Len(t, graph.Nodes, 5)
+	assert.Equal(t, "data.genesyscloud_auth_division.home", graph.Nodes[0].Address)
+	assert.True(t, graph.Nodes[0].DataSource)
+
+	// References to objects that were not exported and alternative values are not edges
+	assert.Equal(t, []dependencyGraphEdge{
+		{From: "genesyscloud_flow.flow_a", To: "genesyscloud_flow.flow_b", Kind: dependencyEdgeDependsOn, Cyclic: true},
+		{From: "genesyscloud_flow.flow_a", To: "genesyscloud_routing_queue.queue_1", Kind: dependencyEdgeReference, Attribute: "config.queueIds"},
+		{From: "genesyscloud_flow.flow_b", To: "genesyscloud_flow.flow_a", Kind: dependencyEdgeDependsOn, Cyclic: true},
+		{From: "genesyscloud_routing_queue.queue_1", To: "data.genesyscloud_auth_division.home", Kind: dependencyEdgeReference, Attribute: "division_id"},
+		{From: "genesyscloud_routing_queue.queue_1", To: "genesyscloud_user.user_1", Kind: dependencyEdgeReference, Attribute: "members.user_id"},
+		{From: "genesyscloud_user.user_1", To: "data.genesyscloud_auth_division.home", Kind: dependencyEdgeReference, Attribute: "division_id"},
+	}, graph.Edges)
+
+	dot := graph.dot()
+	assert.Contains(t, dot, `"genesyscloud_flow.flow_a" -> "genesyscloud_flow.flow_b" [label = "depends_on", style = "dotted", color = "red", penwidth = 2];`)
+	assert.Contains(t, dot, `"genesyscloud_user.user_1" -> "data.genesyscloud_auth_division.home" [label = "division_id"];`)
+
+	assert.Nil(t, g.writeDependencyGraph())
+	data, err := os.ReadFile(filepath.Join(g.exportDirPath, defaultDependenciesJSONFile))
+	assert.Nil(t, err)
+	var written dependencyGraph
+	assert.Nil(t, json.Unmarshal(data, &written))
+	assert.Equal(t, *graph, written)
+	_, err = os.Stat(filepath.Join(g.exportDirPath, defaultDependenciesDOTFile))
+	assert.Nil(t, err)
+}
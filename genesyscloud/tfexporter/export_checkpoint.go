@@ -0,0 +1,233 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains all of the logic used to resume a failed export. While the objects are read, their state is recorded in a checkpoint
+file in the export directory. When an export fails, the next apply with resume enabled reuses the state of the objects that were already
+read and only reads the remaining objects. Resource types whose objects were all read are not listed again. The checkpoint is removed
+once an export completes.
+*/
+
+const exportCheckpointFormatVersion = 2
+
+type exportCheckpoint struct {
+	FormatVersion int `json:"format_version"`
+
+	// Resource type -> objects listed for the type. Only holds the types whose objects have all been read.
+	CompletedTypes map[string]resourceExporter.ResourceIDMetaMap `json:"completed_types"`
+
+	// Resource type -> object ID -> entry
+	Resources map[string]map[string]*exportManifestEntry `json:"resources"`
+
+	mutex sync.Mutex
+}
+
+func newExportCheckpoint() *exportCheckpoint {
+	return &exportCheckpoint{
+		FormatVersion:  exportCheckpointFormatVersion,
+		CompletedTypes: make(map[string]resourceExporter.ResourceIDMetaMap),
+		Resources:      make(map[string]map[string]*exportManifestEntry),
+	}
+}
+
+// readExportCheckpoint reads the checkpoint of a failed export. A nil checkpoint is returned if the file does not exist.
+func readExportCheckpoint(path string) (*exportCheckpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	checkpoint := newExportCheckpoint()
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, err
+	}
+
+	if checkpoint.FormatVersion != exportCheckpointFormatVersion {
+		log.Printf("Ignoring export checkpoint %s with unsupported format version %d", path, checkpoint.FormatVersion)
+		return nil, nil
+	}
+	return checkpoint, nil
+}
+
+func (c *exportCheckpoint) state(resType string, id string) *terraform.InstanceState {
+	if c == nil {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.Resources[resType][id]
+	if !ok {
+		return nil
+	}
+	return &terraform.InstanceState{
+		ID:         entry.StateId,
+		Attributes: copyStringMap(entry.Attributes),
+		Meta:       entry.Meta,
+	}
+}
+
+func (c *exportCheckpoint) addResource(resType string, id string, resource resourceExporter.ResourceInfo) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.Resources[resType] == nil {
+		c.Resources[resType] = make(map[string]*exportManifestEntry)
+	}
+	c.Resources[resType][id] = &exportManifestEntry{
+		Name:       resource.Name,
+		StateId:    resource.State.ID,
+		Attributes: resource.State.Attributes,
+		Meta:       resource.State.Meta,
+	}
+}
+
+// completeType records the objects listed for a resource type once they have all been read. The objects listed by the first pass
+// over a type are kept, so the types read again to export dependencies do not replace them.
+func (c *exportCheckpoint) completeType(resType string, resources resourceExporter.ResourceIDMetaMap) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.CompletedTypes[resType]; ok {
+		return
+	}
+	c.CompletedTypes[resType] = copyResourceIDMetaMap(resources)
+}
+
+// completedType returns the objects listed for a resource type if they were all read
+func (c *exportCheckpoint) completedType(resType string) (resourceExporter.ResourceIDMetaMap, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	resources, ok := c.CompletedTypes[resType]
+	if !ok {
+		return nil, false
+	}
+	return copyResourceIDMetaMap(resources), true
+}
+
+func copyResourceIDMetaMap(resources resourceExporter.ResourceIDMetaMap) resourceExporter.ResourceIDMetaMap {
+	resourcesCopy := make(resourceExporter.ResourceIDMetaMap, len(resources))
+	for id, meta := range resources {
+		metaCopy := *meta
+		resourcesCopy[id] = &metaCopy
+	}
+	return resourcesCopy
+}
+
+func (c *exportCheckpoint) write(path string) diag.Diagnostics {
+	if c == nil {
+		return nil
+	}
+
+	c.mutex.Lock()
+	data, err := json.Marshal(c)
+	c.mutex.Unlock()
+	if err != nil {
+		return diag.Errorf("Failed to encode export checkpoint as JSON: %v", err)
+	}
+	// The checkpoint holds the state of the objects read so far
+	return files.WriteToPrivateFile(data, path)
+}
+
+func (g *GenesysCloudResourceExporter) exportCheckpointPath() string {
+	return filepath.Join(g.exportDirPath, defaultExportCheckpointFile)
+}
+
+// loadExportCheckpoint reuses the checkpoint of a failed export when resume is enabled. Otherwise any previous checkpoint is discarded.
+func (g *GenesysCloudResourceExporter) loadExportCheckpoint() diag.Diagnostics {
+	checkpointPath := g.exportCheckpointPath()
+	if g.resume {
+		checkpoint, err := readExportCheckpoint(checkpointPath)
+		if err != nil {
+			return diag.Errorf("Failed to read export checkpoint %s: %v", checkpointPath, err)
+		}
+		if checkpoint != nil {
+			objects := 0
+			for _, resources := range checkpoint.Resources {
+				objects += len(resources)
+			}
+			log.Printf("Resuming export from %s. %d resource types completed and %d objects already read.", checkpointPath, len(checkpoint.CompletedTypes), objects)
+			g.checkpoint = checkpoint
+			return nil
+		}
+		log.Printf("No export checkpoint found in %s. Running a full export.", g.exportDirPath)
+	} else if err := os.Remove(checkpointPath); err != nil && !os.IsNotExist(err) {
+		return diag.Errorf("Failed to remove export checkpoint %s: %v", checkpointPath, err)
+	}
+
+	g.checkpoint = newExportCheckpoint()
+	return nil
+}
+
+// getCheckpointedResourceState returns the state of an object read by a previous attempt of the export
+func (g *GenesysCloudResourceExporter) getCheckpointedResourceState(resType string, id string) *terraform.InstanceState {
+	if !g.resume {
+		return nil
+	}
+	return g.checkpoint.state(resType, id)
+}
+
+// restoreCompletedTypes loads the objects of the resource types completed by the export being resumed from the checkpoint. The
+// exporters of the remaining resource types, which still have to be listed, are returned.
+func (g *GenesysCloudResourceExporter) restoreCompletedTypes(exporters map[string]*resourceExporter.ResourceExporter) map[string]*resourceExporter.ResourceExporter {
+	if !g.resume {
+		return exporters
+	}
+
+	remaining := make(map[string]*resourceExporter.ResourceExporter)
+	for resType, exporter := range exporters {
+		resources, ok := g.checkpoint.completedType(resType)
+		if !ok {
+			remaining[resType] = exporter
+			continue
+		}
+		log.Printf("Reusing the %d objects of resource type %s listed before the export was resumed", len(resources), resType)
+		g.report.typeStarted(resType)
+		exporter.SanitizedResourceMap = resources
+	}
+	return remaining
+}
+
+// writeExportCheckpoint records the objects read so far so a failed export can be resumed
+func (g *GenesysCloudResourceExporter) writeExportCheckpoint() {
+	if g.checkpoint == nil {
+		return
+	}
+	if diagErr := g.checkpoint.write(g.exportCheckpointPath()); diagErr != nil {
+		log.Printf("Failed to write export checkpoint: %v", diagErr)
+	}
+}
+
+// removeExportCheckpoint removes the checkpoint once the export has completed
+func (g *GenesysCloudResourceExporter) removeExportCheckpoint() diag.Diagnostics {
+	if err := os.Remove(g.exportCheckpointPath()); err != nil && !os.IsNotExist(err) {
+		return diag.Errorf("Failed to remove export checkpoint %s: %v", g.exportCheckpointPath(), err)
+	}
+	g.checkpoint = nil
+	return nil
+}
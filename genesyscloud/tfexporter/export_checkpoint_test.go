@@ -0,0 +1,62 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportCheckpoint(t *testing.T) {
+	exportDir := t.TempDir()
+	resType := "genesyscloud_routing_queue"
+	checkpointPath := filepath.Join(exportDir, defaultExportCheckpointFile)
+
+	// A failed export records the objects read so far
+	g := &GenesysCloudResourceExporter{exportDirPath: exportDir}
+	diagErr := g.loadExportCheckpoint()
+	assert.Nil(t, diagErr)
+	g.checkpoint.addResource(resType, "queue-1", resourceExporter.ResourceInfo{
+		Name:  "queue_1",
+		Type:  resType,
+		State: &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{"id": "queue-1", "name": "queue 1"}},
+	})
+	g.checkpoint.completeType(resType, resourceExporter.ResourceIDMetaMap{"queue-1": {Name: "queue_1"}})
+	g.writeExportCheckpoint()
+
+	// Resuming reuses the recorded state
+	resumed := &GenesysCloudResourceExporter{exportDirPath: exportDir, resume: true}
+	diagErr = resumed.loadExportCheckpoint()
+	assert.Nil(t, diagErr)
+	state := resumed.getCheckpointedResourceState(resType, "queue-1")
+	assert.NotNil(t, state)
+	assert.Equal(t, "queue-1", state.ID)
+	assert.Equal(t, "queue 1", state.Attributes["name"])
+	assert.Nil(t, resumed.getCheckpointedResourceState(resType, "queue-2"))
+
+	// Completed resource types are not listed again
+	queueExporter := &resourceExporter.ResourceExporter{}
+	userExporter := &resourceExporter.ResourceExporter{}
+	resumed.report = newExportReport()
+	remaining := resumed.restoreCompletedTypes(map[string]*resourceExporter.ResourceExporter{resType: queueExporter, "genesyscloud_user": userExporter})
+	assert.Equal(t, map[string]*resourceExporter.ResourceExporter{"genesyscloud_user": userExporter}, remaining)
+	assert.Equal(t, "queue_1", queueExporter.SanitizedResourceMap["queue-1"].Name)
+
+	// A completed export removes the checkpoint
+	diagErr = resumed.removeExportCheckpoint()
+	assert.Nil(t, diagErr)
+	_, err := os.Stat(checkpointPath)
+	assert.True(t, os.IsNotExist(err))
+
+	// Without resume a previous checkpoint is discarded
+	g.writeExportCheckpoint()
+	fresh := &GenesysCloudResourceExporter{exportDirPath: exportDir}
+	diagErr = fresh.loadExportCheckpoint()
+	assert.Nil(t, diagErr)
+	assert.Nil(t, fresh.getCheckpointedResourceState(resType, "queue-1"))
+	_, err = os.Stat(checkpointPath)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -3,6 +3,7 @@ package tfexporter
 import (
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -14,17 +15,33 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const (
-	defaultTfJSONFile          = "genesyscloud.tf.json"
-	defaultTfHCLFile           = "genesyscloud.tf"
-	defaultTfHCLProviderFile   = "provider.tf"
-	defaultTfJSONProviderFile  = "provider.tf.json"
-	defaultTfHCLVariablesFile  = "variables.tf"
-	defaultTfJSONVariablesFile = "variables.tf.json"
-	defaultTfVarsFile          = "terraform.tfvars"
-	defaultTfStateFile         = "terraform.tfstate"
+	defaultTfJSONFile             = "genesyscloud.tf.json"
+	defaultTfHCLFile              = "genesyscloud.tf"
+	defaultTfHCLProviderFile      = "provider.tf"
+	defaultTfJSONProviderFile     = "provider.tf.json"
+	defaultTfHCLVariablesFile     = "variables.tf"
+	defaultTfJSONVariablesFile    = "variables.tf.json"
+	defaultTfVarsFile             = "terraform.tfvars"
+	defaultTfStateFile            = "terraform.tfstate"
+	defaultExportManifestFile     = "export_manifest.json"
+	defaultExportCheckpointFile   = "export_checkpoint.json"
+	defaultExportReportFile       = "export_report.json"
+	defaultDependenciesDOTFile    = "dependencies.dot"
+	defaultDependenciesJSONFile   = "dependencies.json"
+	defaultTfHCLImportsFile       = "imports.tf"
+	defaultTfJSONImportsFile      = "imports.tf.json"
+	defaultTfHCLMovedFile         = "moved.tf"
+	defaultTfJSONMovedFile        = "moved.tf.json"
+	defaultExportLockFile         = "export.lock.json"
+	defaultCdktfConfigFile        = "cdktf.json"
+	defaultCdktfTypeScriptFile    = "main.ts"
+	defaultCdktfGoFile            = "main.go"
+	defaultExportDiffJSONFile     = "export_diff.json"
+	defaultExportDiffMarkdownFile = "export_diff.md"
 )
 
 // Common Exporter interface to abstract away whether we are using HCL or JSON as our exporter
@@ -70,11 +87,16 @@ func FilterResourceByName(result resourceExporter.ResourceIDMetaMap, name string
 		for _, f := range filter {
 			n := fmt.Sprintf("%v::", name)
 
-			if strings.Contains(f, n) {
+			if strings.Contains(f, n) && !isAttributePredicate(strings.Replace(f, n, "", 1)) {
 				names = append(names, strings.Replace(f, n, "", 1))
 			}
 		}
 
+		// Only attribute predicates were given. These are applied once the objects have been read.
+		if len(names) == 0 {
+			return result
+		}
+
 		newResult := make(resourceExporter.ResourceIDMetaMap)
 		for _, name := range names {
 			for k, v := range result {
@@ -119,6 +141,9 @@ func IncludeFilterResourceByRegex(result resourceExporter.ResourceIDMetaMap, nam
 		if strings.Contains(f, "::") && strings.Split(f, "::")[0] == name {
 			i := strings.Index(f, "::")
 			regexStr := f[i+2:]
+			if isAttributePredicate(regexStr) {
+				continue
+			}
 			newFilters = append(newFilters, regexStr)
 		}
 	}
@@ -148,6 +173,9 @@ func ExcludeFilterResourceByRegex(result resourceExporter.ResourceIDMetaMap, nam
 		if strings.Contains(f, "::") && strings.Split(f, "::")[0] == name {
 			i := strings.Index(f, "::")
 			regexStr := f[i+2:]
+			if isAttributePredicate(regexStr) {
+				continue
+			}
 			newFilters = append(newFilters, regexStr)
 		}
 	}
@@ -174,6 +202,119 @@ func ExcludeFilterResourceByRegex(result resourceExporter.ResourceIDMetaMap, nam
 	return newResourceMap
 }
 
+// attributePredicateRegex matches filters of the form {attribute}{operator}{value} where the operator is one of ==, != or =~
+var attributePredicateRegex = regexp.MustCompile(`^([A-Za-z0-9_.]+)(==|!=|=~)(.*)$`)
+
+// attributePredicate is a filter evaluated against the read state of an object, e.g. genesyscloud_user::state==active
+type attributePredicate struct {
+	attribute string
+	operator  string
+	value     string
+	pattern   *regexp.Regexp
+}
+
+func isAttributePredicate(filter string) bool {
+	return attributePredicateRegex.MatchString(filter)
+}
+
+// parseAttributePredicates returns the attribute predicates in the filter list for a resource type
+func parseAttributePredicates(resType string, filter []string) []attributePredicate {
+	predicates := make([]attributePredicate, 0)
+	for _, f := range filter {
+		typeName, expression, found := strings.Cut(f, "::")
+		if !found || typeName != resType {
+			continue
+		}
+
+		matches := attributePredicateRegex.FindStringSubmatch(expression)
+		if matches == nil {
+			continue
+		}
+
+		predicate := attributePredicate{attribute: matches[1], operator: matches[2], value: matches[3]}
+		if predicate.operator == "=~" {
+			pattern, err := regexp.Compile(predicate.value)
+			if err != nil {
+				log.Printf("Ignoring filter %s with invalid regular expression: %v", f, err)
+				continue
+			}
+			predicate.pattern = pattern
+		}
+		predicates = append(predicates, predicate)
+	}
+	return predicates
+}
+
+// matches evaluates the predicate against the flattened state attributes. Nested attributes are addressed without list
+// indexes, e.g. 'routing_skills.skill_id', and match if any of the values match. A missing attribute has an empty value.
+func (p attributePredicate) matches(state *terraform.InstanceState) bool {
+	values := make([]string, 0)
+	if state != nil {
+		for key, value := range state.Attributes {
+			if attributePathWithoutIndexes(key) == p.attribute {
+				values = append(values, value)
+			}
+		}
+	}
+	if len(values) == 0 {
+		values = append(values, "")
+	}
+
+	switch p.operator {
+	case "==":
+		return lists.ItemInSlice(p.value, values)
+	case "!=":
+		return !lists.ItemInSlice(p.value, values)
+	case "=~":
+		for _, value := range values {
+			if p.pattern.MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// attributePathWithoutIndexes strips the list and set indexes from a flatmap key. Count keys return an empty path.
+func attributePathWithoutIndexes(key string) string {
+	parts := strings.Split(key, ".")
+	path := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part == "#" || part == "%" {
+			return ""
+		}
+		if _, err := strconv.Atoi(part); err == nil {
+			continue
+		}
+		path = append(path, part)
+	}
+	return strings.Join(path, ".")
+}
+
+// FilterResourcesByAttributes keeps the objects of a resource type that match any of its attribute predicates when including
+// and drops them when excluding. Objects of types without attribute predicates are returned unchanged.
+func FilterResourcesByAttributes(resources []resourceExporter.ResourceInfo, resType string, filter []string, include bool) []resourceExporter.ResourceInfo {
+	predicates := parseAttributePredicates(resType, filter)
+	if len(predicates) == 0 {
+		return resources
+	}
+
+	filtered := make([]resourceExporter.ResourceInfo, 0, len(resources))
+	for _, resource := range resources {
+		matched := false
+		for _, predicate := range predicates {
+			if predicate.matches(resource.State) {
+				matched = true
+				break
+			}
+		}
+		if matched == include {
+			filtered = append(filtered, resource)
+		}
+	}
+	return filtered
+}
+
 /*
 This file is used to hold common methods that are used across the exporter.  They do not have strong affinity to any one particular export process (e.g. HCL or JSON).
 */
@@ -0,0 +1,559 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+/*
+This file contains all of the functions used to compare two export directories, for example an export of a production org with an
+export of a staging org, or yesterday's export of an org with today's. The resources of both exports are compared attribute by
+attribute and the resources that were added, removed or changed are written to a Markdown and a JSON file. GUIDs are replaced with
+the address of the resource they belong to in the tfstate file of their export, so GUIDs that differ between orgs but reference
+resources with the same name are not reported as changes. When the resource schemas are available, nested blocks of a set type, such as
+the members of a queue, are compared regardless of their order.
+*/
+
+// Attributes that only order the resources of an export and are not compared
+var exportDiffIgnoredAttributes = []string{"depends_on", "lifecycle"}
+
+type ExportDiff struct {
+	FromDirectory string               `json:"from_directory"`
+	ToDirectory   string               `json:"to_directory"`
+	Added         []string             `json:"added"`
+	Removed       []string             `json:"removed"`
+	Changed       []ExportDiffResource `json:"changed"`
+}
+
+type ExportDiffResource struct {
+	Address    string                `json:"address"`
+	Attributes []ExportDiffAttribute `json:"attributes"`
+}
+
+// ExportDiffAttribute holds the values of a changed attribute. A nil value means the attribute is not set in that export.
+type ExportDiffAttribute struct {
+	Attribute string      `json:"attribute"`
+	From      interface{} `json:"from"`
+	To        interface{} `json:"to"`
+}
+
+// DiffExportDirectories compares the resources exported to two directories and writes the differences to the output directory.
+// The resource schemas are optional. Without them, nested blocks are compared by their position.
+func DiffExportDirectories(fromDirectory string, toDirectory string, outputDirectory string, resources map[string]*schema.Resource) (*ExportDiff, diag.Diagnostics) {
+	fromResources, diagErr := readExportDirectory(fromDirectory, resources)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	toResources, diagErr := readExportDirectory(toDirectory, resources)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	exportDiff := diffExportResources(fromResources, toResources)
+	exportDiff.FromDirectory = fromDirectory
+	exportDiff.ToDirectory = toDirectory
+
+	log.Printf("Export diff of %s and %s: %d added, %d removed, %d changed", fromDirectory, toDirectory, len(exportDiff.Added), len(exportDiff.Removed), len(exportDiff.Changed))
+	return exportDiff, exportDiff.write(outputDirectory)
+}
+
+// readExportDirectory reads the flattened attributes of every resource and data source of an export keyed by their address
+func readExportDirectory(directory string, resources map[string]*schema.Resource) (map[string]map[string]interface{}, diag.Diagnostics) {
+	if _, err := os.Stat(directory); err != nil {
+		return nil, diag.Errorf("failed to read export directory %s: %v", directory, err)
+	}
+
+	guidAddresses := readExportStateAddresses(directory)
+	exportResources := make(map[string]map[string]interface{})
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".terraform" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		var configs map[string]map[string]interface{}
+		if strings.HasSuffix(path, ".tf.json") {
+			configs, err = readExportJSONFile(path)
+		} else if filepath.Ext(path) == ".tf" {
+			configs, err = readExportHCLFile(path)
+		} else {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		modulePrefix := exportDiffModulePrefix(directory, path)
+		for address, config := range configs {
+			var resourceSchema map[string]*schema.Schema
+			if resource, ok := resources[strings.Split(address, ".")[0]]; ok {
+				resourceSchema = resource.Schema
+			}
+			attributes := make(map[string]interface{})
+			flattenExportDiffValue("", normalizeExportDiffValue(config, guidAddresses), resourceSchema, attributes)
+			exportResources[modulePrefix+address] = attributes
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, diag.Errorf("failed to read export directory %s: %v", directory, err)
+	}
+	return exportResources, nil
+}
+
+// exportDiffModulePrefix returns the module address of config files written to a child module of a module_layout export
+func exportDiffModulePrefix(directory string, path string) string {
+	relativePath, err := filepath.Rel(directory, path)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(filepath.ToSlash(relativePath), "/")
+	if len(parts) >= 3 && parts[0] == defaultModulesDirectory {
+		return "module." + parts[1] + "."
+	}
+	return ""
+}
+
+// ReadProviderSchemaFile reads the resource schemas from the output of `terraform providers schema -json`. Only the nested blocks
+// are read, since they are all the export diff needs from the schemas.
+func ReadProviderSchemaFile(path string) (map[string]*schema.Resource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	var providerSchemas struct {
+		ProviderSchemas map[string]struct {
+			ResourceSchemas map[string]struct {
+				Block providerSchemaBlock `json:"block"`
+			} `json:"resource_schemas"`
+		} `json:"provider_schemas"`
+	}
+	if err := json.Unmarshal(data, &providerSchemas); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	resources := make(map[string]*schema.Resource)
+	for _, providerSchema := range providerSchemas.ProviderSchemas {
+		for resourceType, resourceSchema := range providerSchema.ResourceSchemas {
+			resources[resourceType] = resourceSchema.Block.resource()
+		}
+	}
+	return resources, nil
+}
+
+type providerSchemaBlock struct {
+	BlockTypes map[string]struct {
+		NestingMode string              `json:"nesting_mode"`
+		Block       providerSchemaBlock `json:"block"`
+	} `json:"block_types"`
+}
+
+func (b providerSchemaBlock) resource() *schema.Resource {
+	resource := &schema.Resource{Schema: make(map[string]*schema.Schema)}
+	for name, blockType := range b.BlockTypes {
+		blockSchema := &schema.Schema{Type: schema.TypeList, Elem: blockType.Block.resource()}
+		if blockType.NestingMode == "set" {
+			blockSchema.Type = schema.TypeSet
+		}
+		resource.Schema[name] = blockSchema
+	}
+	return resource
+}
+
+// readExportStateAddresses maps the ID of every resource in the tfstate file of an export to its address
+func readExportStateAddresses(directory string) map[string]string {
+	guidAddresses := make(map[string]string)
+	data, err := os.ReadFile(filepath.Join(directory, defaultTfStateFile))
+	if err != nil {
+		return guidAddresses
+	}
+
+	var state struct {
+		Resources []struct {
+			Module    string `json:"module"`
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		log.Printf("Failed to parse the tfstate file of %s. GUIDs will be compared as they are: %v", directory, err)
+		return guidAddresses
+	}
+
+	for _, resource := range state.Resources {
+		address := resource.Type + "." + resource.Name
+		if resource.Mode == "data" {
+			address = "data." + address
+		}
+		if resource.Module != "" {
+			address = resource.Module + "." + address
+		}
+		for _, instance := range resource.Instances {
+			if id, ok := instance.Attributes["id"].(string); ok && id != "" {
+				guidAddresses[id] = address
+			}
+		}
+	}
+	return guidAddresses
+}
+
+func readExportJSONFile(path string) (map[string]map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	var config struct {
+		Resource map[string]map[string]map[string]interface{} `json:"resource"`
+		Data     map[string]map[string]map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	configs := make(map[string]map[string]interface{})
+	for resourceType, resources := range config.Resource {
+		for name, resourceConfig := range resources {
+			configs[resourceType+"."+name] = resourceConfig
+		}
+	}
+	for dataSourceType, dataSources := range config.Data {
+		for name, dataSourceConfig := range dataSources {
+			configs["data."+dataSourceType+"."+name] = dataSourceConfig
+		}
+	}
+	return configs, nil
+}
+
+func readExportHCLFile(path string) (map[string]map[string]interface{}, error) {
+	parser := hclparse.NewParser()
+	file, hclDiags := parser.ParseHCLFile(path)
+	if hclDiags.HasErrors() {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, hclDiags)
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse %s: unexpected body type", path)
+	}
+
+	configs := make(map[string]map[string]interface{})
+	for _, block := range body.Blocks {
+		if len(block.Labels) != 2 {
+			continue
+		}
+		address := block.Labels[0] + "." + block.Labels[1]
+		switch block.Type {
+		case "resource":
+		case "data":
+			address = "data." + address
+		default:
+			continue
+		}
+		configs[address] = hclBodyToMap(block.Body, file.Bytes)
+	}
+	return configs, nil
+}
+
+// hclBodyToMap converts the attributes and nested blocks of an HCL body to the same structure as a JSON export
+func hclBodyToMap(body *hclsyntax.Body, source []byte) map[string]interface{} {
+	config := make(map[string]interface{})
+	for name, attribute := range body.Attributes {
+		config[name] = hclExpressionValue(attribute.Expr, source)
+	}
+	for _, block := range body.Blocks {
+		blocks, _ := config[block.Type].([]interface{})
+		config[block.Type] = append(blocks, hclBodyToMap(block.Body, source))
+	}
+	return config
+}
+
+// hclExpressionValue returns the value of an expression. Expressions that cannot be evaluated without a context, such as references
+// to other resources or variables, are returned as interpolation strings like the ones in a JSON export.
+func hclExpressionValue(expr hclsyntax.Expression, source []byte) interface{} {
+	switch e := expr.(type) {
+	case *hclsyntax.TupleConsExpr:
+		values := make([]interface{}, 0, len(e.Exprs))
+		for _, item := range e.Exprs {
+			values = append(values, hclExpressionValue(item, source))
+		}
+		return values
+	case *hclsyntax.ObjectConsExpr:
+		values := make(map[string]interface{})
+		for _, item := range e.Items {
+			key := hclExpressionValue(item.KeyExpr, source)
+			if keyExpr, ok := item.KeyExpr.(*hclsyntax.ObjectConsKeyExpr); ok {
+				if name := hcl.ExprAsKeyword(keyExpr.Wrapped); name != "" {
+					key = name
+				}
+			}
+			values[fmt.Sprintf("%v", key)] = hclExpressionValue(item.ValueExpr, source)
+		}
+		return values
+	}
+
+	if value, hclDiags := expr.Value(nil); !hclDiags.HasErrors() {
+		if data, err := (ctyjson.SimpleJSONValue{Value: value}).MarshalJSON(); err == nil {
+			var result interface{}
+			if err := json.Unmarshal(data, &result); err == nil {
+				return result
+			}
+		}
+	}
+
+	text := string(expr.Range().SliceBytes(source))
+	switch expr.(type) {
+	case *hclsyntax.TemplateExpr, *hclsyntax.TemplateWrapExpr:
+		if unquoted, err := strconv.Unquote(text); err == nil {
+			return unquoted
+		}
+		return strings.TrimSuffix(strings.TrimPrefix(text, `"`), `"`)
+	}
+	return "${" + text + "}"
+}
+
+// normalizeExportDiffValue removes the attributes that are not compared and replaces GUIDs of exported resources with a reference
+// to the resource
+func normalizeExportDiffValue(value interface{}, guidAddresses map[string]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if isExportDiffIgnoredAttribute(key) {
+				continue
+			}
+			normalized[key] = normalizeExportDiffValue(item, guidAddresses)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			normalized = append(normalized, normalizeExportDiffValue(item, guidAddresses))
+		}
+		return normalized
+	case string:
+		if address, ok := guidAddresses[v]; ok {
+			return "${" + address + ".id}"
+		}
+	}
+	return value
+}
+
+func isExportDiffIgnoredAttribute(attribute string) bool {
+	for _, ignored := range exportDiffIgnoredAttributes {
+		if attribute == ignored {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenExportDiffValue flattens nested blocks to attribute paths such as media_settings_call.0.alerting_timeout_sec. Lists of
+// primitive values are compared as a whole and in sorted order, since most of them are sets. Nested blocks that are a set in the
+// block schema, such as the members of a queue, are also compared as a whole, as a sorted list of their flattened attributes.
+func flattenExportDiffValue(path string, value interface{}, blockSchema map[string]*schema.Schema, attributes map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 && path != "" {
+			attributes[path] = v
+			return
+		}
+		for key, item := range v {
+			attributeSchema := blockSchema[key]
+			if items, ok := item.([]interface{}); ok && attributeSchema != nil && attributeSchema.Type == schema.TypeSet && !isPrimitiveList(items) {
+				attributes[joinExportDiffPath(path, key)] = flattenExportDiffSet(items, exportDiffElemSchema(attributeSchema))
+				continue
+			}
+			flattenExportDiffValue(joinExportDiffPath(path, key), item, exportDiffElemSchema(attributeSchema), attributes)
+		}
+	case []interface{}:
+		if isPrimitiveList(v) {
+			sorted := append([]interface{}{}, v...)
+			sort.SliceStable(sorted, func(i, j int) bool {
+				return fmt.Sprintf("%v", sorted[i]) < fmt.Sprintf("%v", sorted[j])
+			})
+			attributes[path] = sorted
+			return
+		}
+		for i, item := range v {
+			flattenExportDiffValue(joinExportDiffPath(path, strconv.Itoa(i)), item, blockSchema, attributes)
+		}
+	default:
+		attributes[path] = v
+	}
+}
+
+// flattenExportDiffSet flattens every block of a set and sorts them by their JSON encoding, so sets with the same blocks in a
+// different order are equal
+func flattenExportDiffSet(items []interface{}, elemSchema map[string]*schema.Schema) []interface{} {
+	type setItem struct {
+		key        string
+		attributes map[string]interface{}
+	}
+	setItems := make([]setItem, 0, len(items))
+	for _, item := range items {
+		attributes := make(map[string]interface{})
+		flattenExportDiffValue("", item, elemSchema, attributes)
+		key, _ := json.Marshal(attributes)
+		setItems = append(setItems, setItem{key: string(key), attributes: attributes})
+	}
+	sort.SliceStable(setItems, func(i, j int) bool {
+		return setItems[i].key < setItems[j].key
+	})
+
+	sorted := make([]interface{}, 0, len(setItems))
+	for _, item := range setItems {
+		sorted = append(sorted, item.attributes)
+	}
+	return sorted
+}
+
+// exportDiffElemSchema returns the schema of the attributes of a nested block, or nil if the attribute is not a block
+func exportDiffElemSchema(attributeSchema *schema.Schema) map[string]*schema.Schema {
+	if attributeSchema == nil {
+		return nil
+	}
+	if elem, ok := attributeSchema.Elem.(*schema.Resource); ok {
+		return elem.Schema
+	}
+	return nil
+}
+
+func joinExportDiffPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func isPrimitiveList(values []interface{}) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return false
+		}
+	}
+	return true
+}
+
+func diffExportResources(fromResources map[string]map[string]interface{}, toResources map[string]map[string]interface{}) *ExportDiff {
+	exportDiff := &ExportDiff{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+		Changed: make([]ExportDiffResource, 0),
+	}
+
+	for _, address := range sortedKeys(fromResources) {
+		if _, ok := toResources[address]; !ok {
+			exportDiff.Removed = append(exportDiff.Removed, address)
+		}
+	}
+	for _, address := range sortedKeys(toResources) {
+		fromAttributes, ok := fromResources[address]
+		if !ok {
+			exportDiff.Added = append(exportDiff.Added, address)
+			continue
+		}
+		if attributes := diffExportAttributes(fromAttributes, toResources[address]); len(attributes) > 0 {
+			exportDiff.Changed = append(exportDiff.Changed, ExportDiffResource{Address: address, Attributes: attributes})
+		}
+	}
+	return exportDiff
+}
+
+func diffExportAttributes(fromAttributes map[string]interface{}, toAttributes map[string]interface{}) []ExportDiffAttribute {
+	paths := make(map[string]bool)
+	for path := range fromAttributes {
+		paths[path] = true
+	}
+	for path := range toAttributes {
+		paths[path] = true
+	}
+
+	attributes := make([]ExportDiffAttribute, 0)
+	for _, path := range sortedKeys(paths) {
+		fromValue, toValue := fromAttributes[path], toAttributes[path]
+		if !reflect.DeepEqual(fromValue, toValue) {
+			attributes = append(attributes, ExportDiffAttribute{Attribute: path, From: fromValue, To: toValue})
+		}
+	}
+	return attributes
+}
+
+func (e *ExportDiff) write(outputDirectory string) diag.Diagnostics {
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return diag.Errorf("failed to encode export diff as JSON: %v", err)
+	}
+	if diagErr := files.WriteToFile(data, filepath.Join(outputDirectory, defaultExportDiffJSONFile)); diagErr != nil {
+		return diagErr
+	}
+	return files.WriteToFile([]byte(e.markdown()), filepath.Join(outputDirectory, defaultExportDiffMarkdownFile))
+}
+
+func (e *ExportDiff) markdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Export Diff\n\n")
+	sb.WriteString(fmt.Sprintf("Compared `%s` (from) with `%s` (to).\n\n", e.FromDirectory, e.ToDirectory))
+	sb.WriteString("| Change | Resources |\n|---|---|\n")
+	sb.WriteString(fmt.Sprintf("| Added | %d |\n| Removed | %d |\n| Changed | %d |\n", len(e.Added), len(e.Removed), len(e.Changed)))
+
+	writeAddresses := func(title string, addresses []string) {
+		if len(addresses) == 0 {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n", title))
+		for _, address := range addresses {
+			sb.WriteString(fmt.Sprintf("- `%s`\n", address))
+		}
+	}
+	writeAddresses("Added Resources", e.Added)
+	writeAddresses("Removed Resources", e.Removed)
+
+	if len(e.Changed) > 0 {
+		sb.WriteString("\n## Changed Resources\n")
+		for _, resource := range e.Changed {
+			sb.WriteString(fmt.Sprintf("\n### `%s`\n\n| Attribute | From | To |\n|---|---|---|\n", resource.Address))
+			for _, attribute := range resource.Attributes {
+				sb.WriteString(fmt.Sprintf("| `%s` | %s | %s |\n", attribute.Attribute, markdownExportDiffValue(attribute.From), markdownExportDiffValue(attribute.To)))
+			}
+		}
+	}
+	return sb.String()
+}
+
+func markdownExportDiffValue(value interface{}) string {
+	if value == nil {
+		return "_not set_"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		data = []byte(fmt.Sprintf("%v", value))
+	}
+	text := strings.ReplaceAll(string(data), "|", `\|`)
+	return "`" + strings.ReplaceAll(text, "\n", " ") + "`"
+}
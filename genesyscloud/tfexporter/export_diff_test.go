@@ -0,0 +1,189 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportDiffDirectories(t *testing.T) {
+	fromDir := t.TempDir()
+	toDir := t.TempDir()
+	outputDir := t.TempDir()
+
+	// The division of the production org is referenced by its GUID
+	writeExportDiffTestFile(t, filepath.Join(fromDir, defaultTfHCLFile), `
+resource "genesyscloud_routing_queue" "Support" {
+  name           = "Support"
+  division_id    = "1111-prod"
+  acw_timeout_ms = 300000
+  wrapup_codes   = [genesyscloud_routing_wrapupcode.Billing.id, genesyscloud_routing_wrapupcode.Sales.id]
+  media_settings_call {
+    alerting_timeout_sec = 8
+  }
+  depends_on = [genesyscloud_routing_wrapupcode.Billing]
+}
+
+resource "genesyscloud_routing_wrapupcode" "Billing" {
+  name = "Billing"
+}
+
+resource "genesyscloud_routing_wrapupcode" "Old" {
+  name = "Old"
+}
+`)
+	writeExportDiffTestFile(t, filepath.Join(fromDir, defaultTfStateFile), `{
+  "resources": [
+    {"mode": "managed", "type": "genesyscloud_auth_division", "name": "Sales", "instances": [{"attributes": {"id": "1111-prod"}}]}
+  ]
+}`)
+
+	// The staging org is exported as JSON and its division has another GUID
+	writeExportDiffTestFile(t, filepath.Join(toDir, defaultTfJSONFile), `{
+  "resource": {
+    "genesyscloud_routing_queue": {
+      "Support": {
+        "name": "Support",
+        "division_id": "2222-staging",
+        "acw_timeout_ms": 600000,
+        "wrapup_codes": ["${genesyscloud_routing_wrapupcode.Sales.id}", "${genesyscloud_routing_wrapupcode.Billing.id}"],
+        "media_settings_call": [{"alerting_timeout_sec": 8}]
+      }
+    },
+    "genesyscloud_routing_wrapupcode": {
+      "Billing": {"name": "Billing"},
+      "New": {"name": "New"}
+    }
+  }
+}`)
+	writeExportDiffTestFile(t, filepath.Join(toDir, defaultTfStateFile), `{
+  "resources": [
+    {"mode": "managed", "type": "genesyscloud_auth_division", "name": "Sales", "instances": [{"attributes": {"id": "2222-staging"}}]}
+  ]
+}`)
+
+	exportDiff, diagErr := DiffExportDirectories(fromDir, toDir, outputDir, nil)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, []string{"genesyscloud_routing_wrapupcode.New"}, exportDiff.Added)
+	assert.Equal(t, []string{"genesyscloud_routing_wrapupcode.Old"}, exportDiff.Removed)
+	assert.Equal(t, []ExportDiffResource{
+		{
+			Address:    "genesyscloud_routing_queue.Support",
+			Attributes: []ExportDiffAttribute{{Attribute: "acw_timeout_ms", From: float64(300000), To: float64(600000)}},
+		},
+	}, exportDiff.Changed)
+
+	data, err := os.ReadFile(filepath.Join(outputDir, defaultExportDiffJSONFile))
+	assert.Nil(t, err)
+	var written ExportDiff
+	assert.Nil(t, json.Unmarshal(data, &written))
+	assert.Equal(t, exportDiff.Added, written.Added)
+
+	markdown, err := os.ReadFile(filepath.Join(outputDir, defaultExportDiffMarkdownFile))
+	assert.Nil(t, err)
+	assert.Contains(t, string(markdown), "| Added | 1 |")
+	assert.Contains(t, string(markdown), "- `genesyscloud_routing_wrapupcode.Old`")
+	assert.Contains(t, string(markdown), "### `genesyscloud_routing_queue.Support`")
+	assert.Contains(t, string(markdown), "| `acw_timeout_ms` | `300000` | `600000` |")
+
+	_, diagErr = DiffExportDirectories(filepath.Join(fromDir, "missing"), toDir, outputDir, nil)
+	assert.NotNil(t, diagErr)
+}
+
+func TestUnitTfExportDiffSetBlocks(t *testing.T) {
+	fromDir := t.TempDir()
+	toDir := t.TempDir()
+
+	// The members are a set and are exported in another order. The media settings are a list.
+	writeExportDiffTestFile(t, filepath.Join(fromDir, defaultTfHCLFile), `
+resource "genesyscloud_routing_queue" "Support" {
+  members {
+    user_id  = "${genesyscloud_user.Alice.id}"
+    ring_num = 1
+  }
+  members {
+    user_id  = "${genesyscloud_user.Bob.id}"
+    ring_num = 2
+  }
+  media_settings_call {
+    alerting_timeout_sec = 8
+  }
+}
+`)
+	writeExportDiffTestFile(t, filepath.Join(toDir, defaultTfJSONFile), `{
+  "resource": {
+    "genesyscloud_routing_queue": {
+      "Support": {
+        "members": [{"user_id": "${genesyscloud_user.Bob.id}", "ring_num": 2}, {"user_id": "${genesyscloud_user.Alice.id}", "ring_num": 1}],
+        "media_settings_call": [{"alerting_timeout_sec": 8}]
+      }
+    }
+  }
+}`)
+
+	schemaFile := filepath.Join(t.TempDir(), "schema.json")
+	writeExportDiffTestFile(t, schemaFile, `{
+  "provider_schemas": {
+    "registry.terraform.io/mypurecloud/genesyscloud": {
+      "resource_schemas": {
+        "genesyscloud_routing_queue": {
+          "block": {
+            "block_types": {
+              "members": {"nesting_mode": "set", "block": {}},
+              "media_settings_call": {"nesting_mode": "list", "block": {}}
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+	resources, err := ReadProviderSchemaFile(schemaFile)
+	assert.Nil(t, err)
+	assert.Equal(t, schema.TypeSet, resources["genesyscloud_routing_queue"].Schema["members"].Type)
+	assert.Equal(t, schema.TypeList, resources["genesyscloud_routing_queue"].Schema["media_settings_call"].Type)
+
+	exportDiff, diagErr := DiffExportDirectories(fromDir, toDir, t.TempDir(), resources)
+	assert.Nil(t, diagErr)
+	assert.Empty(t, exportDiff.Changed)
+
+	// Without the schema, the members are compared by their position
+	exportDiff, diagErr = DiffExportDirectories(fromDir, toDir, t.TempDir(), nil)
+	assert.Nil(t, diagErr)
+	assert.Len(t, exportDiff.Changed, 1)
+
+	// A changed member is reported as a change of the whole set
+	writeExportDiffTestFile(t, filepath.Join(toDir, defaultTfJSONFile), `{
+  "resource": {
+    "genesyscloud_routing_queue": {
+      "Support": {
+        "members": [{"user_id": "${genesyscloud_user.Bob.id}", "ring_num": 3}, {"user_id": "${genesyscloud_user.Alice.id}", "ring_num": 1}],
+        "media_settings_call": [{"alerting_timeout_sec": 8}]
+      }
+    }
+  }
+}`)
+	exportDiff, diagErr = DiffExportDirectories(fromDir, toDir, t.TempDir(), resources)
+	assert.Nil(t, diagErr)
+	if assert.Len(t, exportDiff.Changed, 1) {
+		assert.Equal(t, []string{"members"}, exportDiffAttributeNames(exportDiff.Changed[0]))
+	}
+}
+
+func exportDiffAttributeNames(resource ExportDiffResource) []string {
+	names := make([]string, 0, len(resource.Attributes))
+	for _, attribute := range resource.Attributes {
+		names = append(names, attribute.Attribute)
+	}
+	return names
+}
+
+func writeExportDiffTestFile(t *testing.T, path string, content string) {
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
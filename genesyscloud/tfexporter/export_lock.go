@@ -0,0 +1,245 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains all of the logic used to keep the addresses of the exported resources stable between exports. The address of every
+exported resource is recorded in a lock file in the export directory. On the next export into the same directory, objects that are
+already in the lock keep their address even if they have been renamed, and new objects are not allowed to take the address of a locked
+object. When the address of a locked object has to change, for example because it moved to another child module, a Terraform moved
+block is written so that the object is not destroyed and created again.
+*/
+
+const exportLockFormatVersion = 1
+
+type exportLock struct {
+	FormatVersion int `json:"format_version"`
+
+	// Resource type -> object ID -> resource address
+	Resources map[string]map[string]string `json:"resources"`
+}
+
+type movedBlock struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func newExportLock() *exportLock {
+	return &exportLock{
+		FormatVersion: exportLockFormatVersion,
+		Resources:     make(map[string]map[string]string),
+	}
+}
+
+// readExportLock reads the lock written by a previous export. A nil lock is returned if the file does not exist.
+func readExportLock(path string) (*exportLock, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	lock := newExportLock()
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, err
+	}
+
+	if lock.FormatVersion != exportLockFormatVersion {
+		log.Printf("Ignoring export lock %s with unsupported format version %d", path, lock.FormatVersion)
+		return nil, nil
+	}
+	return lock, nil
+}
+
+func (l *exportLock) address(resType string, id string) (string, bool) {
+	if l == nil {
+		return "", false
+	}
+	address, ok := l.Resources[resType][id]
+	return address, ok
+}
+
+func (l *exportLock) add(resType string, id string, address string) {
+	if l.Resources[resType] == nil {
+		l.Resources[resType] = make(map[string]string)
+	}
+	l.Resources[resType][id] = address
+}
+
+// movedBlocks returns a moved block for every object of the previous lock whose address is different in the next lock
+func (l *exportLock) movedBlocks(next *exportLock) []movedBlock {
+	blocks := make([]movedBlock, 0)
+	if l == nil {
+		return blocks
+	}
+
+	for resType, addresses := range next.Resources {
+		for id, address := range addresses {
+			if previous, ok := l.address(resType, id); ok && previous != address {
+				blocks = append(blocks, movedBlock{From: previous, To: address})
+			}
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].From < blocks[j].From
+	})
+	return blocks
+}
+
+// resourceAddress returns the address of an exported resource, including the child module it is exported to
+func resourceAddress(resType string, name string, resourceModules map[string]string) string {
+	address := resType + "." + name
+	if module := resourceModules[address]; module != "" {
+		address = "module." + module + "." + address
+	}
+	return address
+}
+
+// addressTraversal converts a resource address to an HCL traversal so it can be written without quotes
+func addressTraversal(address string) hcl.Traversal {
+	addressParts := strings.Split(address, ".")
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: addressParts[0]}}
+	for _, part := range addressParts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+	return traversal
+}
+
+func (g *GenesysCloudResourceExporter) loadExportLock() diag.Diagnostics {
+	lockPath := filepath.Join(g.exportDirPath, defaultExportLockFile)
+	lock, err := readExportLock(lockPath)
+	if err != nil {
+		return diag.Errorf("Failed to read export lock %s: %v", lockPath, err)
+	}
+	g.previousLock = lock
+	return nil
+}
+
+// applyExportLock gives the objects recorded in the lock of the previous export their previous names. Objects that are not in the lock
+// and whose name is held by a locked object get a suffix derived from their ID.
+func (g *GenesysCloudResourceExporter) applyExportLock() {
+	if g.previousLock == nil {
+		return
+	}
+
+	var exporters map[string]*resourceExporter.ResourceExporter
+	if g.exporters != nil {
+		exporters = *g.exporters
+	}
+
+	// <type>.<name> -> ID of the locked object holding the name
+	lockedNames := make(map[string]string)
+	for _, resource := range g.resources {
+		if resource.State == nil || g.isDataSource(resource.Type, resource.Name) {
+			continue
+		}
+		if address, ok := g.previousLock.address(resource.Type, resource.State.ID); ok {
+			lockedNames[resource.Type+"."+lockedResourceName(address)] = resource.State.ID
+		}
+	}
+
+	for i, resource := range g.resources {
+		if resource.State == nil || g.isDataSource(resource.Type, resource.Name) {
+			continue
+		}
+
+		name := resource.Name
+		if address, ok := g.previousLock.address(resource.Type, resource.State.ID); ok {
+			name = lockedResourceName(address)
+		} else if id, ok := lockedNames[resource.Type+"."+name]; ok && id != resource.State.ID {
+			algorithm := fnv.New32()
+			algorithm.Write([]byte(resource.State.ID))
+			name = name + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+		}
+
+		if name != resource.Name {
+			log.Printf("Using locked name %s for %s %s instead of %s", name, resource.Type, resource.State.ID, resource.Name)
+			g.resources[i].Name = name
+			g.updateSanitiseMap(exporters, g.resources[i])
+		}
+	}
+}
+
+// writeExportLock records the address of every exported resource and writes moved blocks for the resources whose address changed
+func (g *GenesysCloudResourceExporter) writeExportLock(resourceModules map[string]string) diag.Diagnostics {
+	lock := newExportLock()
+	for _, resource := range g.getManagedResources() {
+		if resource.State == nil || resource.State.ID == "" {
+			continue
+		}
+		lock.add(resource.Type, resource.State.ID, resourceAddress(resource.Type, resource.Name, resourceModules))
+	}
+
+	if blocks := g.previousLock.movedBlocks(lock); len(blocks) > 0 {
+		if diagErr := g.writeMovedBlocks(blocks); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	data, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode export lock as JSON: %v", err)
+	}
+	lockPath := filepath.Join(g.exportDirPath, defaultExportLockFile)
+	log.Printf("Writing export lock to %s", lockPath)
+	return files.WriteToFile(data, lockPath)
+}
+
+func (g *GenesysCloudResourceExporter) writeMovedBlocks(blocks []movedBlock) diag.Diagnostics {
+	var (
+		data []byte
+		path string
+	)
+	if g.exportAsHCL {
+		data = createHCLMovedBlocks(blocks)
+		path = filepath.Join(g.exportDirPath, defaultTfHCLMovedFile)
+	} else {
+		var err error
+		data, err = json.MarshalIndent(util.JsonMap{"moved": blocks}, "", "  ")
+		if err != nil {
+			return diag.Errorf("Failed to encode moved blocks as JSON: %v", err)
+		}
+		path = filepath.Join(g.exportDirPath, defaultTfJSONMovedFile)
+	}
+
+	log.Printf("Writing %d moved blocks to %s", len(blocks), path)
+	return files.WriteToFile(data, path)
+}
+
+func createHCLMovedBlocks(blocks []movedBlock) []byte {
+	f := hclwrite.NewEmptyFile()
+	rootBody := f.Body()
+	for i, block := range blocks {
+		if i > 0 {
+			rootBody.AppendNewline()
+		}
+		movedBody := rootBody.AppendNewBlock("moved", nil).Body()
+		movedBody.SetAttributeTraversal("from", addressTraversal(block.From))
+		movedBody.SetAttributeTraversal("to", addressTraversal(block.To))
+	}
+	return f.Bytes()
+}
+
+// lockedResourceName returns the resource name from a resource address
+func lockedResourceName(address string) string {
+	return address[strings.LastIndex(address, ".")+1:]
+}
@@ -0,0 +1,73 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportLock(t *testing.T) {
+	exportDir := t.TempDir()
+	resType := "genesyscloud_routing_queue"
+
+	newExporter := func(queues map[string]string) *GenesysCloudResourceExporter {
+		exporters := map[string]*resourceExporter.ResourceExporter{
+			resType: {SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{}},
+		}
+		g := &GenesysCloudResourceExporter{
+			exportDirPath: exportDir,
+			exportAsHCL:   true,
+			exporters:     &exporters,
+		}
+		for id, name := range queues {
+			exporters[resType].SanitizedResourceMap[id] = &resourceExporter.ResourceMeta{Name: name}
+			g.resources = append(g.resources, resourceExporter.ResourceInfo{Name: name, Type: resType, State: &terraform.InstanceState{ID: id}})
+		}
+		return g
+	}
+
+	// The first export records the addresses
+	first := newExporter(map[string]string{"queue-1": "Support", "queue-2": "Sales"})
+	assert.Nil(t, first.loadExportLock())
+	assert.Nil(t, first.previousLock)
+	assert.Nil(t, first.writeExportLock(map[string]string{}))
+
+	// Queue 1 was renamed and a new queue took its old name
+	second := newExporter(map[string]string{"queue-1": "Customer_Support", "queue-2": "Sales", "queue-3": "Support"})
+	assert.Nil(t, second.loadExportLock())
+	second.applyExportLock()
+
+	names := make(map[string]string)
+	for _, resource := range second.resources {
+		names[resource.State.ID] = resource.Name
+	}
+	assert.Equal(t, "Support", names["queue-1"])
+	assert.Equal(t, "Sales", names["queue-2"])
+	assert.NotEqual(t, "Support", names["queue-3"])
+	assert.Regexp(t, `^Support_\d+$`, names["queue-3"])
+	assert.Equal(t, "Support", (*second.exporters)[resType].SanitizedResourceMap["queue-1"].Name)
+
+	// No moved blocks are written when the addresses are unchanged
+	assert.Nil(t, second.writeExportLock(map[string]string{}))
+	_, err := os.Stat(filepath.Join(exportDir, defaultTfHCLMovedFile))
+	assert.True(t, os.IsNotExist(err))
+
+	// Moving a queue into a child module writes a moved block
+	third := newExporter(map[string]string{"queue-1": "Support"})
+	assert.Nil(t, third.loadExportLock())
+	third.applyExportLock()
+	assert.Nil(t, third.writeExportLock(map[string]string{resType + ".Support": "home"}))
+
+	moved, err := os.ReadFile(filepath.Join(exportDir, defaultTfHCLMovedFile))
+	assert.Nil(t, err)
+	assert.Contains(t, string(moved), "from = genesyscloud_routing_queue.Support")
+	assert.Contains(t, string(moved), "to   = module.home.genesyscloud_routing_queue.Support")
+
+	lock, err := readExportLock(filepath.Join(exportDir, defaultExportLockFile))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]map[string]string{resType: {"queue-1": "module.home.genesyscloud_routing_queue.Support"}}, lock.Resources)
+}
@@ -0,0 +1,238 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"log"
+	"path/filepath"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains the report written at the end of every export. The report lists each exported resource type with the number of
+objects exported, the objects that were skipped and why, the references to objects that were not exported and the time spent on the
+type. It is written whether the export succeeds or fails so that pipelines can check the outcome of an export without parsing the
+provider logs.
+*/
+
+const exportReportFormatVersion = 1
+
+// Reasons an object or a whole resource type is missing from the export
+const (
+	skipReasonPermission        = "permission"
+	skipReasonProductNotEnabled = "product_not_enabled"
+	skipReasonReadError         = "read_error"
+	skipReasonDeleted           = "deleted"
+)
+
+type exportReport struct {
+	FormatVersion   int                                  `json:"format_version"`
+	Success         bool                                 `json:"success"`
+	Error           string                               `json:"error,omitempty"`
+	StartTime       time.Time                            `json:"start_time"`
+	ElapsedSeconds  float64                              `json:"elapsed_seconds"`
+	ObjectsExported int                                  `json:"objects_exported"`
+	ObjectsSkipped  int                                  `json:"objects_skipped"`
+	ResourceTypes   map[string]*exportReportResourceType `json:"resource_types"`
+
+	mutex sync.Mutex
+}
+
+type exportReportResourceType struct {
+	Objects              int                               `json:"objects"`
+	Skipped              []exportReportSkippedObject       `json:"skipped"`
+	UnresolvedReferences []exportReportUnresolvedReference `json:"unresolved_references"`
+	ElapsedSeconds       float64                           `json:"elapsed_seconds"`
+
+	start     time.Time
+	completed time.Time
+}
+
+type exportReportSkippedObject struct {
+	// The ID and name are empty when every object of the resource type was skipped
+	Id      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Reason  string `json:"reason"`
+	Message string `json:"message,omitempty"`
+}
+
+type exportReportUnresolvedReference struct {
+	ResourceName string `json:"resource_name"`
+	Attribute    string `json:"attribute"`
+	RefType      string `json:"ref_type"`
+	RefId        string `json:"ref_id"`
+}
+
+func newExportReport() *exportReport {
+	return &exportReport{
+		FormatVersion: exportReportFormatVersion,
+		StartTime:     time.Now(),
+		ResourceTypes: make(map[string]*exportReportResourceType),
+	}
+}
+
+// skipReason classifies the error returned when an object or resource type could not be read
+func skipReason(message string) string {
+	if strings.Contains(message, "403") {
+		return skipReasonPermission
+	}
+	if strings.Contains(message, "501") {
+		return skipReasonProductNotEnabled
+	}
+	return skipReasonReadError
+}
+
+// resourceType returns the entry of a resource type. The caller must hold the mutex.
+func (r *exportReport) resourceType(resType string) *exportReportResourceType {
+	entry, ok := r.ResourceTypes[resType]
+	if !ok {
+		entry = &exportReportResourceType{
+			Skipped:              make([]exportReportSkippedObject, 0),
+			UnresolvedReferences: make([]exportReportUnresolvedReference, 0),
+		}
+		r.ResourceTypes[resType] = entry
+	}
+	return entry
+}
+
+func (r *exportReport) typeStarted(resType string) {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if entry := r.resourceType(resType); entry.start.IsZero() {
+		entry.start = time.Now()
+	}
+}
+
+func (r *exportReport) typeCompleted(resType string) {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.resourceType(resType).completed = time.Now()
+}
+
+func (r *exportReport) addSkipped(resType string, id string, name string, reason string, message string) {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	entry := r.resourceType(resType)
+	entry.Skipped = append(entry.Skipped, exportReportSkippedObject{
+		Id:      id,
+		Name:    name,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func (r *exportReport) addUnresolvedReference(resType string, resName string, attribute string, refType string, refId string) {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	entry := r.resourceType(resType)
+	reference := exportReportUnresolvedReference{
+		ResourceName: resName,
+		Attribute:    attribute,
+		RefType:      refType,
+		RefId:        refId,
+	}
+	for _, existing := range entry.UnresolvedReferences {
+		if existing == reference {
+			return
+		}
+	}
+	entry.UnresolvedReferences = append(entry.UnresolvedReferences, reference)
+}
+
+// clearUnresolvedReferences is called before the resource config is rebuilt so that only the references of the final config are reported
+func (r *exportReport) clearUnresolvedReferences() {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, entry := range r.ResourceTypes {
+		entry.UnresolvedReferences = make([]exportReportUnresolvedReference, 0)
+	}
+}
+
+// complete sets the object counts and elapsed times from the exported objects and the outcome of the export
+func (r *exportReport) complete(objectCounts map[string]int, exportErr diag.Diagnostics) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	r.ElapsedSeconds = now.Sub(r.StartTime).Seconds()
+	r.Success = !exportErr.HasError()
+	if r.Success {
+		r.Error = ""
+	} else {
+		var messages []string
+		for _, d := range exportErr {
+			messages = append(messages, d.Summary)
+		}
+		r.Error = strings.Join(messages, "\n")
+	}
+
+	for resType, count := range objectCounts {
+		r.resourceType(resType).Objects = count
+	}
+
+	r.ObjectsExported = 0
+	r.ObjectsSkipped = 0
+	for _, entry := range r.ResourceTypes {
+		r.ObjectsExported += entry.Objects
+		r.ObjectsSkipped += len(entry.Skipped)
+
+		end := entry.completed
+		if end.IsZero() {
+			end = now
+		}
+		if !entry.start.IsZero() {
+			entry.ElapsedSeconds = end.Sub(entry.start).Seconds()
+		}
+	}
+}
+
+// writeExportReport writes the outcome of the export to the export directory
+func (g *GenesysCloudResourceExporter) writeExportReport(exportErr diag.Diagnostics) {
+	if g.report == nil || g.exportDirPath == "" {
+		return
+	}
+
+	objectCounts := make(map[string]int)
+	for _, resource := range g.resources {
+		objectCounts[resource.Type]++
+	}
+	g.report.complete(objectCounts, exportErr)
+
+	g.report.mutex.Lock()
+	data, err := json.MarshalIndent(g.report, "", "  ")
+	g.report.mutex.Unlock()
+	if err != nil {
+		log.Printf("Failed to encode export report as JSON: %v", err)
+		return
+	}
+
+	reportPath := filepath.Join(g.exportDirPath, defaultExportReportFile)
+	log.Printf("Writing export report to %s", reportPath)
+	if diagErr := files.WriteToFile(data, reportPath); diagErr != nil {
+		log.Printf("Failed to write export report: %v", diagErr)
+	}
+}
@@ -0,0 +1,89 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportReport(t *testing.T) {
+	exportDir := t.TempDir()
+	queueType := "genesyscloud_routing_queue"
+	userType := "genesyscloud_user"
+	wrapupcodeType := "genesyscloud_routing_wrapupcode"
+
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		queueType: {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"members.user_id":   {RefType: userType},
+				"default_script_id": {RefType: "genesyscloud_script", AltValues: []string{"none"}},
+				"queue_flow_id":     {RefType: "genesyscloud_flow"},
+			},
+		},
+		userType: {
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{"user-1": {Name: "user_1"}},
+		},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		exportDirPath: exportDir,
+		exporters:     &exporters,
+		resources: []resourceExporter.ResourceInfo{
+			{Name: "queue_1", Type: queueType, State: &terraform.InstanceState{ID: "queue-1"}},
+			{Name: "queue_2", Type: queueType, State: &terraform.InstanceState{ID: "queue-2"}},
+			{Name: "user_1", Type: userType, State: &terraform.InstanceState{ID: "user-1"}},
+		},
+		report: newExportReport(),
+	}
+
+	g.report.typeStarted(queueType)
+	g.report.typeStarted(userType)
+	g.report.typeStarted(wrapupcodeType)
+	g.report.addSkipped(wrapupcodeType, "", "", skipReason("API Error: 403 - Missing permission"), "API Error: 403 - Missing permission")
+	g.report.addSkipped(queueType, "queue-3", "queue_3", skipReasonDeleted, "")
+	g.report.typeCompleted(queueType)
+
+	// References to exported objects and alternative values are not reported
+	assert.Equal(t, "${genesyscloud_user.user_1.id}", g.resolveAttributeReference(queueType, "queue_1", "members.user_id", exporters[queueType].RefAttrs["members.user_id"], "user-1", exporters, false))
+	assert.Equal(t, "none", g.resolveAttributeReference(queueType, "queue_1", "default_script_id", exporters[queueType].RefAttrs["default_script_id"], "none", exporters, false))
+
+	// References to objects that were not exported are reported once
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, "", g.resolveAttributeReference(queueType, "queue_1", "queue_flow_id", exporters[queueType].RefAttrs["queue_flow_id"], "flow-1", exporters, false))
+	}
+
+	g.writeExportReport(diag.Errorf("Failed to get state for %s instance %s", queueType, "queue-4"))
+
+	data, err := os.ReadFile(filepath.Join(exportDir, defaultExportReportFile))
+	assert.Nil(t, err)
+	var report exportReport
+	assert.Nil(t, json.Unmarshal(data, &report))
+
+	assert.False(t, report.Success)
+	assert.Contains(t, report.Error, "queue-4")
+	assert.Equal(t, 3, report.ObjectsExported)
+	assert.Equal(t, 2, report.ObjectsSkipped)
+
+	queues := report.ResourceTypes[queueType]
+	assert.Equal(t, 2, queues.Objects)
+	assert.Equal(t, []exportReportSkippedObject{{Id: "queue-3", Name: "queue_3", Reason: skipReasonDeleted}}, queues.Skipped)
+	assert.Equal(t, []exportReportUnresolvedReference{{ResourceName: "queue_1", Attribute: "queue_flow_id", RefType: "genesyscloud_flow", RefId: "flow-1"}}, queues.UnresolvedReferences)
+
+	wrapupcodes := report.ResourceTypes[wrapupcodeType]
+	assert.Equal(t, 0, wrapupcodes.Objects)
+	assert.Len(t, wrapupcodes.Skipped, 1)
+	assert.Equal(t, skipReasonPermission, wrapupcodes.Skipped[0].Reason)
+
+	assert.Equal(t, skipReasonProductNotEnabled, skipReason("API Error: 501 - Not Implemented"))
+	assert.Equal(t, skipReasonReadError, skipReason("context deadline exceeded"))
+
+	// Rebuilding the config clears the unresolved references
+	g.report.clearUnresolvedReferences()
+	assert.Empty(t, g.report.ResourceTypes[queueType].UnresolvedReferences)
+}
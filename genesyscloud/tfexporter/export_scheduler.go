@@ -0,0 +1,208 @@
+package tfexporter
+
+import (
+	"context"
+	"log"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/ratelimit"
+	"time"
+)
+
+/*
+This file contains the scheduler used to read the state of the exported objects. The number of concurrent reads adapts to the rate limits
+reported by Genesys Cloud: all reads are paused while the platform asks clients to back off, the limit is halved whenever a throttled
+response is seen and it grows back by one after a run of reads without throttling. Waiting reads of resource types that other types
+depend on are started first, and progress is written to the log as resource types complete.
+*/
+
+const (
+	defaultExportConcurrency = 10
+
+	// Number of reads without throttling before the concurrency limit is raised again
+	exportConcurrencyIncreaseAfter = 20
+
+	// Number of times a read that failed with a 429 is retried after the SDK retries have been exhausted
+	maxThrottledReadRetries = 5
+)
+
+type exportScheduler struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+
+	throttle       *ratelimit.Throttle
+	maxConcurrency int
+	concurrency    int
+	active         int
+
+	// Resource type -> priority. Types with a lower value are read first.
+	priorities map[string]int
+	// Priority -> number of reads waiting to start
+	waiting map[int]int
+
+	readsSinceThrottle int
+	lastThrottledCount uint64
+
+	typesTotal     int
+	typesCompleted int
+	objectsRead    int
+	start          time.Time
+}
+
+func newExportScheduler(exporters map[string]*resourceExporter.ResourceExporter, maxConcurrency int, throttle *ratelimit.Throttle) *exportScheduler {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	s := &exportScheduler{
+		throttle:           throttle,
+		maxConcurrency:     maxConcurrency,
+		concurrency:        maxConcurrency,
+		priorities:         exportTypePriorities(exporters),
+		waiting:            make(map[int]int),
+		lastThrottledCount: throttle.ThrottledCount(),
+		typesTotal:         len(exporters),
+		start:              time.Now(),
+	}
+	s.cond = sync.NewCond(&s.mutex)
+	return s
+}
+
+// exportConcurrency returns the maximum number of concurrent reads. This matches the number of clients of the SDK client pool of
+// the provider instance running the export that can be used at the same time.
+func exportConcurrency(meta interface{}) int {
+	if pool := exportClientPool(meta); pool != nil && pool.MaxConcurrency() > 0 {
+		return pool.MaxConcurrency()
+	}
+	return defaultExportConcurrency
+}
+
+// exportThrottle returns the throttle of the SDK client pool of the provider instance running the export. Rate limits reported
+// for the org of another provider instance do not pause the export.
+func exportThrottle(meta interface{}) *ratelimit.Throttle {
+	if pool := exportClientPool(meta); pool != nil {
+		return pool.Throttle()
+	}
+	return ratelimit.NewThrottle()
+}
+
+func exportClientPool(meta interface{}) *provider.SDKClientPool {
+	if providerMeta, ok := meta.(*provider.ProviderMeta); ok && providerMeta.ClientPool != nil {
+		return providerMeta.ClientPool
+	}
+	return provider.SdkClientPool
+}
+
+// exportTypePriorities orders the resource types by their dependencies. Types that do not reference any other exported type have a
+// priority of 0 and every other type has a priority one higher than the highest priority type it references.
+func exportTypePriorities(exporters map[string]*resourceExporter.ResourceExporter) map[string]int {
+	priorities := make(map[string]int)
+	visiting := make(map[string]bool)
+
+	var priority func(resType string) int
+	priority = func(resType string) int {
+		if p, ok := priorities[resType]; ok {
+			return p
+		}
+		if visiting[resType] {
+			// Cyclic reference
+			return 0
+		}
+		visiting[resType] = true
+
+		p := 0
+		if exporter := exporters[resType]; exporter != nil {
+			for _, refSettings := range exporter.RefAttrs {
+				if refSettings == nil || refSettings.RefType == resType {
+					continue
+				}
+				if _, ok := exporters[refSettings.RefType]; !ok {
+					continue
+				}
+				if refPriority := priority(refSettings.RefType) + 1; refPriority > p {
+					p = refPriority
+				}
+			}
+		}
+
+		visiting[resType] = false
+		priorities[resType] = p
+		return p
+	}
+
+	for resType := range exporters {
+		priority(resType)
+	}
+	return priorities
+}
+
+// acquire blocks until a read of the resource type may start. Reads are held while the platform is throttling requests.
+func (s *exportScheduler) acquire(resType string) {
+	if s == nil {
+		return
+	}
+	_ = s.throttle.Wait(context.Background())
+
+	priority := s.priorities[resType]
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.waiting[priority]++
+	for s.active >= s.concurrency || s.higherPriorityWaiting(priority) {
+		s.cond.Wait()
+	}
+	s.waiting[priority]--
+	if s.waiting[priority] == 0 {
+		delete(s.waiting, priority)
+	}
+	s.active++
+}
+
+// release marks a read as complete and adjusts the concurrency limit to the observed throttling
+func (s *exportScheduler) release() {
+	if s == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	s.active--
+	if throttledCount := s.throttle.ThrottledCount(); throttledCount > s.lastThrottledCount {
+		s.lastThrottledCount = throttledCount
+		s.readsSinceThrottle = 0
+		if s.concurrency > 1 {
+			s.concurrency = s.concurrency / 2
+			log.Printf("Export reads are being throttled. Reducing concurrent reads to %d", s.concurrency)
+		}
+	} else {
+		s.readsSinceThrottle++
+		if s.readsSinceThrottle >= exportConcurrencyIncreaseAfter && s.concurrency < s.maxConcurrency {
+			s.readsSinceThrottle = 0
+			s.concurrency++
+		}
+	}
+	s.mutex.Unlock()
+	s.cond.Broadcast()
+}
+
+// typeCompleted logs the progress of the export once all objects of a resource type have been read
+func (s *exportScheduler) typeCompleted(resType string, objects int) {
+	if s == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.typesCompleted++
+	s.objectsRead += objects
+	log.Printf("Export progress: %d/%d resource types completed, %d objects read in %v. Completed %s with %d objects.",
+		s.typesCompleted, s.typesTotal, s.objectsRead, time.Since(s.start).Round(time.Second), resType, objects)
+}
+
+func (s *exportScheduler) higherPriorityWaiting(priority int) bool {
+	for p, count := range s.waiting {
+		if p < priority && count > 0 {
+			return true
+		}
+	}
+	return false
+}
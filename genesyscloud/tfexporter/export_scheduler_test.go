@@ -0,0 +1,99 @@
+package tfexporter
+
+import (
+	"net/http"
+	"sync"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/ratelimit"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportSchedulerPriorities(t *testing.T) {
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_auth_division": {},
+		"genesyscloud_routing_skill": {},
+		"genesyscloud_routing_queue": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"division_id":       {RefType: "genesyscloud_auth_division"},
+				"skill_groups":      {RefType: "genesyscloud_routing_skill_group"},
+				"bullseye.skills":   {RefType: "genesyscloud_routing_skill"},
+				"queue_flow_id":     {RefType: "genesyscloud_flow"},
+				"outbound_email":    {RefType: "genesyscloud_routing_queue"},
+				"members.user_id":   {RefType: "genesyscloud_user"},
+				"default_script_id": nil,
+			},
+		},
+		"genesyscloud_user": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"division_id": {RefType: "genesyscloud_auth_division"},
+			},
+		},
+	}
+
+	priorities := exportTypePriorities(exporters)
+	assert.Equal(t, 0, priorities["genesyscloud_auth_division"])
+	assert.Equal(t, 0, priorities["genesyscloud_routing_skill"])
+	assert.Equal(t, 1, priorities["genesyscloud_user"])
+	assert.Equal(t, 2, priorities["genesyscloud_routing_queue"])
+
+	// Cyclic references do not recurse forever
+	cyclic := map[string]*resourceExporter.ResourceExporter{
+		"a": {RefAttrs: map[string]*resourceExporter.RefAttrSettings{"b_id": {RefType: "b"}}},
+		"b": {RefAttrs: map[string]*resourceExporter.RefAttrSettings{"a_id": {RefType: "a"}}},
+	}
+	assert.Len(t, exportTypePriorities(cyclic), 2)
+}
+
+func TestUnitTfExportSchedulerConcurrency(t *testing.T) {
+	throttle := ratelimit.NewThrottle()
+	exporters := map[string]*resourceExporter.ResourceExporter{"genesyscloud_user": {}}
+	s := newExportScheduler(exporters, 4, throttle)
+
+	// Reads never exceed the concurrency limit
+	var (
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		active    int
+		maxActive int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.acquire("genesyscloud_user")
+			mutex.Lock()
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			mutex.Unlock()
+			time.Sleep(time.Millisecond)
+			mutex.Lock()
+			active--
+			mutex.Unlock()
+			s.release()
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, maxActive, 4)
+
+	// A throttled response halves the limit
+	throttle.ObserveResponse(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"0.01"}}})
+	s.acquire("genesyscloud_user")
+	s.release()
+	assert.Equal(t, 2, s.concurrency)
+
+	// The limit grows back after reads without throttling
+	for i := 0; i < exportConcurrencyIncreaseAfter; i++ {
+		s.acquire("genesyscloud_user")
+		s.release()
+	}
+	assert.Equal(t, 3, s.concurrency)
+
+	s.typeCompleted("genesyscloud_user", 20)
+	assert.Equal(t, 1, s.typesCompleted)
+	assert.Equal(t, 20, s.objectsRead)
+}
@@ -0,0 +1,171 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains all of the logic used to apply the rules of a transformation file to the exported config. A transformation file is
+a JSON object keyed by resource type and attribute path. Each rule renames, sets, drops or templatizes the value of the attribute and
+is applied to the sanitized config of every exported resource of the type before the config is written. This allows org specific
+cleanup of an export without changing the exporter.
+
+	{
+	  "genesyscloud_user": {
+	    "title": { "action": "drop" },
+	    "addresses.phone_numbers.media_type": { "action": "set", "value": "PHONE" }
+	  },
+	  "genesyscloud_routing_queue": {
+	    "acw_timeout_ms": { "action": "drop" },
+	    "description": { "action": "templatize", "template": "{value} (managed by Terraform)" }
+	  }
+	}
+*/
+
+const (
+	transformationActionRename     = "rename"
+	transformationActionSet        = "set"
+	transformationActionDrop       = "drop"
+	transformationActionTemplatize = "templatize"
+
+	// Placeholders of a templatize rule
+	transformationTemplateValue        = "{value}"
+	transformationTemplateResourceType = "{resource_type}"
+	transformationTemplateResourceName = "{resource_name}"
+)
+
+type transformationRule struct {
+	Action string `json:"action"`
+
+	// The new name of a renamed attribute
+	To string `json:"to,omitempty"`
+
+	// The value of a set attribute
+	Value interface{} `json:"value,omitempty"`
+
+	// The template a templatized value is replaced with
+	Template string `json:"template,omitempty"`
+}
+
+// Resource type -> attribute path -> rule
+type exportTransformations map[string]map[string]*transformationRule
+
+func (g *GenesysCloudResourceExporter) setupTransformations() diag.Diagnostics {
+	path, ok := g.d.GetOk("transformation_file")
+	if !ok {
+		return nil
+	}
+
+	transformations, err := readExportTransformations(path.(string))
+	if err != nil {
+		return diag.Errorf("failed to read transformation file %s: %v", path, err)
+	}
+	log.Printf("Read transformation rules for %d resource types from %s", len(transformations), path)
+	g.transformations = transformations
+	return nil
+}
+
+func readExportTransformations(path string) (exportTransformations, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	transformations := make(exportTransformations)
+	if err := json.Unmarshal(data, &transformations); err != nil {
+		return nil, err
+	}
+
+	for resourceType, rules := range transformations {
+		for attribute, rule := range rules {
+			if err := rule.validate(); err != nil {
+				return nil, fmt.Errorf("invalid rule for %s.%s: %v", resourceType, attribute, err)
+			}
+		}
+	}
+	return transformations, nil
+}
+
+func (r *transformationRule) validate() error {
+	if r == nil {
+		return fmt.Errorf("rule is empty")
+	}
+
+	switch r.Action {
+	case transformationActionRename:
+		if r.To == "" || strings.Contains(r.To, ".") {
+			return fmt.Errorf("%s requires 'to' to be an attribute name", r.Action)
+		}
+	case transformationActionSet:
+		if r.Value == nil {
+			return fmt.Errorf("%s requires a 'value'. Use %s to remove an attribute", r.Action, transformationActionDrop)
+		}
+	case transformationActionDrop:
+	case transformationActionTemplatize:
+		if r.Template == "" {
+			return fmt.Errorf("%s requires a 'template'", r.Action)
+		}
+	default:
+		return fmt.Errorf("unknown action '%s'. Valid actions are %s, %s, %s and %s", r.Action, transformationActionRename, transformationActionSet, transformationActionDrop, transformationActionTemplatize)
+	}
+	return nil
+}
+
+// transformConfigMap applies the rules for a resource type to the config of one of its resources. Attribute paths into nested blocks
+// are applied to every block.
+func (g *GenesysCloudResourceExporter) transformConfigMap(resourceType string, resourceName string, configMap map[string]interface{}) {
+	rules := g.transformations[resourceType]
+	for _, attribute := range sortedKeys(rules) {
+		rule := rules[attribute]
+		applyTransformationRule(configMap, strings.Split(attribute, "."), func(parent map[string]interface{}, key string) {
+			rule.apply(resourceType, resourceName, parent, key)
+		})
+	}
+}
+
+func applyTransformationRule(configMap map[string]interface{}, path []string, apply func(map[string]interface{}, string)) {
+	if len(path) == 1 {
+		apply(configMap, path[0])
+		return
+	}
+
+	switch child := configMap[path[0]].(type) {
+	case map[string]interface{}:
+		applyTransformationRule(child, path[1:], apply)
+	case []interface{}:
+		for _, item := range child {
+			if block, ok := item.(map[string]interface{}); ok {
+				applyTransformationRule(block, path[1:], apply)
+			}
+		}
+	}
+}
+
+func (r *transformationRule) apply(resourceType string, resourceName string, configMap map[string]interface{}, key string) {
+	value, exists := configMap[key]
+	if r.Action != transformationActionSet && (!exists || value == nil) {
+		return
+	}
+
+	switch r.Action {
+	case transformationActionRename:
+		delete(configMap, key)
+		configMap[r.To] = value
+	case transformationActionSet:
+		configMap[key] = r.Value
+	case transformationActionDrop:
+		delete(configMap, key)
+	case transformationActionTemplatize:
+		configMap[key] = strings.NewReplacer(
+			transformationTemplateValue, fmt.Sprintf("%v", value),
+			transformationTemplateResourceType, resourceType,
+			transformationTemplateResourceName, resourceName,
+		).Replace(r.Template)
+	}
+}
@@ -0,0 +1,140 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportTransformations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transformations.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{
+  "genesyscloud_user": {
+    "title": { "action": "drop" },
+    "department": { "action": "rename", "to": "division_name" },
+    "addresses.phone_numbers.media_type": { "action": "set", "value": "PHONE" },
+    "email": { "action": "templatize", "template": "${var.email_prefix}{value}" },
+    "profile_skills": { "action": "templatize", "template": "{resource_type}.{resource_name}" }
+  }
+}`), 0644))
+
+	transformations, err := readExportTransformations(path)
+	assert.Nil(t, err)
+
+	g := &GenesysCloudResourceExporter{transformations: transformations}
+	configMap := map[string]interface{}{
+		"title":      "Agent",
+		"department": "Support",
+		"email":      "jane@example.com",
+		"addresses": []interface{}{
+			map[string]interface{}{
+				"phone_numbers": []interface{}{
+					map[string]interface{}{"number": "+13175550100"},
+					map[string]interface{}{"number": "+13175550101", "media_type": "SMS"},
+				},
+			},
+		},
+	}
+	g.transformConfigMap("genesyscloud_user", "Jane", configMap)
+
+	assert.Equal(t, map[string]interface{}{
+		"division_name": "Support",
+		"email":         "${var.email_prefix}jane@example.com",
+		"addresses": []interface{}{
+			map[string]interface{}{
+				"phone_numbers": []interface{}{
+					map[string]interface{}{"number": "+13175550100", "media_type": "PHONE"},
+					map[string]interface{}{"number": "+13175550101", "media_type": "PHONE"},
+				},
+			},
+		},
+	}, configMap)
+
+	// Resources of other types are not transformed
+	queue := map[string]interface{}{"title": "Queue"}
+	g.transformConfigMap("genesyscloud_routing_queue", "Support", queue)
+	assert.Equal(t, "Queue", queue["title"])
+
+	invalidRules := map[string]string{
+		`{"genesyscloud_user": {"title": {"action": "replace"}}}`:                    "unknown action",
+		`{"genesyscloud_user": {"title": {"action": "rename", "to": "a.b"}}}`:        "requires 'to'",
+		`{"genesyscloud_user": {"title": {"action": "set"}}}`:                        "requires a 'value'",
+		`{"genesyscloud_user": {"title": {"action": "templatize", "template": ""}}}`: "requires a 'template'",
+	}
+	for rules, message := range invalidRules {
+		assert.Nil(t, os.WriteFile(path, []byte(rules), 0644))
+		_, err := readExportTransformations(path)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), message)
+		}
+	}
+
+	// A missing transformation file fails the export
+	d := schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{"transformation_file": filepath.Join(t.TempDir(), "missing.json")})
+	g = &GenesysCloudResourceExporter{d: d}
+	assert.NotNil(t, g.setupTransformations())
+}
+
+func TestUnitTfExportTransformationsDropVariables(t *testing.T) {
+	resType := "genesyscloud_unit_test_resource"
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":     {Type: schema.TypeString},
+			"site":     {Type: schema.TypeString},
+			"password": {Type: schema.TypeString},
+			"edge_id":  {Type: schema.TypeString},
+		},
+	}
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		resType: {
+			EnvironmentSpecificAttributes: []string{"name", "site"},
+			UnResolvableAttributes: map[string]*schema.Schema{
+				"password": testResource.Schema["password"],
+				"edge_id":  testResource.Schema["edge_id"],
+			},
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{"id-1": {Name: "trunk"}},
+		},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		parameterize: true,
+		exporters:    &exporters,
+		transformations: exportTransformations{
+			resType: {
+				"site":     {Action: transformationActionDrop},
+				"password": {Action: transformationActionDrop},
+				"edge_id":  {Action: transformationActionRename, To: "edge"},
+			},
+		},
+		resources: []resourceExporter.ResourceInfo{
+			{
+				Name: "trunk",
+				Type: resType,
+				State: &terraform.InstanceState{
+					ID: "id-1",
+					Attributes: map[string]string{
+						"name":     "Trunk",
+						"site":     "Indianapolis",
+						"password": "secret",
+						"edge_id":  "edge-1",
+					},
+				},
+				CtyType: testResource.CoreConfigSchema().ImpliedType(),
+			},
+		},
+	}
+	assert.Nil(t, g.buildResourceConfigMap())
+
+	// The variables of dropped attributes are not written. Renamed attributes keep their variable.
+	variables := make([]string, 0)
+	for _, attr := range g.unresolvedAttrs {
+		variables = append(variables, createUnresolvedAttrKey(attr))
+	}
+	assert.ElementsMatch(t, []string{resType + "_trunk_name", resType + "_trunk_edge_id"}, variables)
+	assert.Equal(t, "${var."+resType+"_trunk_edge_id}", g.resourceTypesMaps[resType]["trunk"]["edge"])
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"log"
+	"net/http"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -57,6 +58,10 @@ type unresolvableAttributeInfo struct {
 	ResourceName string
 	Name         string
 	Schema       *schema.Schema
+
+	// Set for environment specific values that were replaced with a variable by a parameterized export
+	Parameterized bool
+	Value         interface{}
 }
 
 type GenesysCloudResourceExporter struct {
@@ -66,11 +71,31 @@ type GenesysCloudResourceExporter struct {
 	resourceFilter         ExporterResourceFilter
 	filterList             *[]string
 	exportAsHCL            bool
+	exportFormat           string
+	cdktfLanguage          string
+	secretReferenceStyle   string
+	secretPathTemplate     string
 	splitFilesByResource   bool
 	logPermissionErrors    bool
 	addDependsOn           bool
+	transitiveDependencies bool
 	replaceWithDatasource  []string
 	includeStateFile       bool
+	includeImportBlocks    bool
+	incrementalExport      bool
+	previousManifest       *exportManifest
+	previousLock           *exportLock
+	resume                 bool
+	checkpoint             *exportCheckpoint
+	report                 *exportReport
+	moduleLayout           string
+	parameterize           bool
+	environments           []string
+	parameterizedAttrs     map[string]int
+	snapshot               bool
+	keepUnexportedRefs     bool
+	transformations        exportTransformations
+	scheduler              *exportScheduler
 	version                string
 	provider               *schema.Provider
 	exportDirPath          string
@@ -133,26 +158,52 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 	}
 
 	gre := &GenesysCloudResourceExporter{
-		exportAsHCL:          d.Get("export_as_hcl").(bool),
-		splitFilesByResource: d.Get("split_files_by_resource").(bool),
-		logPermissionErrors:  d.Get("log_permission_errors").(bool),
-		addDependsOn:         d.Get("enable_dependency_resolution").(bool),
-		filterType:           filterType,
-		includeStateFile:     d.Get("include_state_file").(bool),
-		ignoreCyclicDeps:     d.Get("ignore_cyclic_deps").(bool),
-		version:              meta.(*provider.ProviderMeta).Version,
-		provider:             provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
-		d:                    d,
-		ctx:                  ctx,
-		meta:                 meta,
-	}
-
-	err := gre.setUpExportDirPath()
+		exportAsHCL:            d.Get("export_as_hcl").(bool),
+		exportFormat:           d.Get("export_format").(string),
+		cdktfLanguage:          d.Get("cdktf_language").(string),
+		secretReferenceStyle:   d.Get("secret_reference_style").(string),
+		secretPathTemplate:     d.Get("secret_path_template").(string),
+		splitFilesByResource:   d.Get("split_files_by_resource").(bool),
+		logPermissionErrors:    d.Get("log_permission_errors").(bool),
+		addDependsOn:           d.Get("enable_dependency_resolution").(bool),
+		transitiveDependencies: d.Get("enable_transitive_dependency_resolution").(bool),
+		filterType:             filterType,
+		includeStateFile:       d.Get("include_state_file").(bool),
+		includeImportBlocks:    d.Get("include_import_blocks").(bool),
+		incrementalExport:      d.Get("incremental_export").(bool),
+		resume:                 d.Get("resume").(bool),
+		moduleLayout:           d.Get("module_layout").(string),
+		parameterize:           d.Get("parameterize").(bool),
+		ignoreCyclicDeps:       d.Get("ignore_cyclic_deps").(bool),
+		version:                meta.(*provider.ProviderMeta).Version,
+		provider:               provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
+		d:                      d,
+		ctx:                    provider.ContextWithClientPool(ctx, meta.(*provider.ProviderMeta).ClientPool),
+		meta:                   meta,
+	}
+
+	err := gre.setupExportFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	err = gre.setupSecretReferences()
+	if err != nil {
+		return nil, err
+	}
+
+	err = gre.setupTransformations()
+	if err != nil {
+		return nil, err
+	}
+
+	err = gre.setUpExportDirPath()
 	if err != nil {
 		return nil, err
 	}
 
 	gre.setupDataSource()
+	gre.setupParameterize()
 
 	//Setting up the filter
 	configureExporterType(ctx, d, gre, filterType)
@@ -160,7 +211,28 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 }
 
 func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
+	// The report is written whether or not the export succeeds
+	g.report = newExportReport()
+	defer func() {
+		g.writeExportReport(diagErr)
+	}()
+
 	// Step #1 Retrieve the exporters we are have registered and have been requested by the user
+	diagErr = g.loadExportManifest()
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = g.loadExportCheckpoint()
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = g.loadExportLock()
+	if diagErr != nil {
+		return diagErr
+	}
+
 	diagErr = g.retrieveExporters()
 	if diagErr != nil {
 		return diagErr
@@ -177,6 +249,12 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 		return diagErr
 	}
 
+	// Add the objects referenced by the exported objects until every reference can be resolved
+	diagErr = g.exportTransitiveDependencies()
+	if diagErr != nil {
+		return diagErr
+	}
+
 	// Step #4 export dependent resources for the flows
 	diagErr = g.buildAndExportDependsOnResourcesForFlows()
 	if diagErr != nil {
@@ -204,6 +282,18 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 	// step #8 Verify the terraform state file with Exporter Resources
 	g.verifyTerraformState()
 
+	// step #9 Record the exported objects for the next incremental export
+	diagErr = g.writeExportManifest()
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// step #10 The export has completed so it no longer needs to be resumed
+	diagErr = g.removeExportCheckpoint()
+	if diagErr != nil {
+		return diagErr
+	}
+
 	return nil
 }
 
@@ -225,6 +315,9 @@ func (g *GenesysCloudResourceExporter) setupDataSource() {
 	}
 	SetDataSourceExports()
 	g.replaceWithDatasource = append(g.replaceWithDatasource, DataSourceExports...)
+	if g.provider != nil {
+		g.replaceWithDatasource = filterDataSourcePatterns(g.replaceWithDatasource, g.provider.DataSourcesMap)
+	}
 }
 
 // retrieveExporters will return a list of all the registered exporters. If the resource_type on the exporter contains any elements, only the defined
@@ -284,7 +377,8 @@ func (g *GenesysCloudResourceExporter) retrieveSanitizedResourceMaps() (diagErr
 	}
 
 	//Retrieve a map of all of the objects we are going to build.  Apply the filter that will remove specific classes of an object
-	diagErr = g.buildSanitizedResourceMaps(*g.exporters, newFilter, g.logPermissionErrors)
+	//The resource types completed by a resumed export are not listed again
+	diagErr = g.buildSanitizedResourceMaps(g.restoreCompletedTypes(*g.exporters), newFilter, g.logPermissionErrors)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -308,6 +402,10 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 
 	ctx, cancel := context.WithCancel(g.ctx)
 	defer cancel()
+
+	// Reads are scheduled in dependency order and slowed down when Genesys Cloud throttles requests
+	g.scheduler = newExportScheduler(*g.exporters, exportConcurrency(g.meta), exportThrottle(g.meta))
+
 	// We use concurrency here to spin off each exporter type and getting the data
 	for resType, exporter := range *g.exporters {
 		wg.Add(1)
@@ -324,6 +422,11 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 				cancel()
 				return
 			}
+			typeResources = g.filterResourcesByAttributes(resType, exporter, typeResources)
+			g.scheduler.typeCompleted(resType, len(typeResources))
+			g.report.typeCompleted(resType)
+			g.checkpoint.completeType(resType, exporter.SanitizedResourceMap)
+			g.writeExportCheckpoint()
 			g.resources = append(g.resources, typeResources...)
 		}(resType, exporter)
 	}
@@ -337,12 +440,37 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 	select {
 	case <-wgDone:
 	case err := <-errorChan:
+		// Keep the objects read so far so the export can be resumed
+		g.writeExportCheckpoint()
 		return err
 	}
 
 	return nil
 }
 
+// filterResourcesByAttributes applies the attribute predicates of the include or exclude filter to the read objects of a resource type.
+// The objects that are filtered out are removed from the sanitized resource map of the exporter, so references to them are handled
+// like references to any other object that is not exported.
+func (g *GenesysCloudResourceExporter) filterResourcesByAttributes(resType string, exporter *resourceExporter.ResourceExporter, resources []resourceExporter.ResourceInfo) []resourceExporter.ResourceInfo {
+	if g.filterList == nil {
+		return resources
+	}
+	filtered := FilterResourcesByAttributes(resources, resType, *g.filterList, g.filterType != ExcludeResources)
+	if len(filtered) == len(resources) {
+		return filtered
+	}
+
+	removed := make(map[string]bool)
+	for _, resource := range resources {
+		removed[resource.State.ID] = true
+	}
+	for _, resource := range filtered {
+		delete(removed, resource.State.ID)
+	}
+	exporter.RemoveSanitizedResources(removed)
+	return filtered
+}
+
 // buildResourceConfigMap Builds a map of all the Terraform resources data returned for each resource
 func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics {
 	log.Printf("Build Genesys Cloud Resources Map")
@@ -350,8 +478,12 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	g.dataSourceTypesMaps = make(map[string]resourceJSONMaps)
 	g.resourceTypesHCLBlocks = make(map[string]resourceHCLBlock, 0)
 	g.unresolvedAttrs = make([]unresolvableAttributeInfo, 0)
+	g.report.clearUnresolvedReferences()
 
-	for _, resource := range g.resources {
+	// Objects exported before keep their previous address
+	g.applyExportLock()
+
+	for i, resource := range g.resources {
 		jsonResult, diagErr := g.instanceStateToMap(resource.State, resource.CtyType)
 		isDataSource := g.isDataSource(resource.Type, resource.Name)
 		if diagErr != nil {
@@ -366,27 +498,35 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 			algorithm := fnv.New32()
 			algorithm.Write([]byte(uuid.NewString()))
 			resource.Name = resource.Name + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+			g.resources[i].Name = resource.Name
 			g.updateSanitiseMap(*g.exporters, resource)
 		}
 
+		var unresolved []unresolvableAttributeInfo
+		parameterizedStart := len(g.unresolvedAttrs)
 		if !isDataSource {
 			// Removes zero values and sets proper reference expressions
-			unresolved, _ := g.sanitizeConfigMap(resource.Type, resource.Name, jsonResult, "", *g.exporters, g.includeStateFile, g.exportAsHCL, true)
-			if len(unresolved) > 0 {
-				g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
-			}
+			unresolved, _ = g.sanitizeConfigMap(resource.Type, resource.Name, jsonResult, "", *g.exporters, g.includeStateFile || g.keepUnexportedRefs, g.exportAsHCL, true)
+			// Applies the rules of the transformation file
+			g.transformConfigMap(resource.Type, resource.Name, jsonResult)
 		} else {
 			g.sanitizeDataConfigMap(jsonResult)
 		}
 
 		// TODO put this in separate call
 		exporters := *g.exporters
-		if resourceFilesWriterFunc := exporters[resource.Type].CustomFileWriter.RetrieveAndWriteFilesFunc; resourceFilesWriterFunc != nil {
+		if resourceFilesWriterFunc := exporters[resource.Type].CustomFileWriter.RetrieveAndWriteFilesFunc; resourceFilesWriterFunc != nil && !g.snapshot {
 			exportDir, _ := getFilePath(g.d, "")
 			if err := resourceFilesWriterFunc(resource.State.ID, exportDir, exporters[resource.Type].CustomFileWriter.SubDirectory, jsonResult, g.meta); err != nil {
+				// The unresolvable attributes keep their variables
 				log.Printf("An error has occurred while trying invoking the RetrieveAndWriteFilesFunc for resource type %s: %v", resource.Type, err)
 			}
 		}
+		// Variables of attributes that were dropped by a transformation rule or set by the file writer are not written
+		g.unresolvedAttrs = append(g.unresolvedAttrs[:parameterizedStart], unresolvedAttrsReferenced(g.unresolvedAttrs[parameterizedStart:], jsonResult)...)
+		if unresolved = unresolvedAttrsReferenced(unresolved, jsonResult); len(unresolved) > 0 {
+			g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
+		}
 
 		if g.exportAsHCL {
 			if _, ok := g.resourceTypesHCLBlocks[resource.Type]; !ok {
@@ -409,6 +549,42 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	return nil
 }
 
+// unresolvedAttrsReferenced returns the unresolvable attributes of a resource whose variable is still referenced by its config
+func unresolvedAttrsReferenced(unresolved []unresolvableAttributeInfo, configMap map[string]interface{}) []unresolvableAttributeInfo {
+	references := make(map[string]bool)
+	collectVariableReferences(configMap, references)
+
+	remaining := make([]unresolvableAttributeInfo, 0, len(unresolved))
+	for _, attr := range unresolved {
+		if references[createUnresolvedAttrKey(attr)] {
+			remaining = append(remaining, attr)
+		}
+	}
+	return remaining
+}
+
+var variableReferenceRegex = regexp.MustCompile(`\$\{var\.([\w-]+)`)
+
+// collectVariableReferences adds the name of every variable referenced by a config value to references
+func collectVariableReferences(value interface{}, references map[string]bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, item := range v {
+			collectVariableReferences(item, references)
+		}
+	case util.JsonMap:
+		collectVariableReferences(map[string]interface{}(v), references)
+	case []interface{}:
+		for _, item := range v {
+			collectVariableReferences(item, references)
+		}
+	case string:
+		for _, match := range variableReferenceRegex.FindAllStringSubmatch(v, -1) {
+			references[match[1]] = true
+		}
+	}
+}
+
 func (g *GenesysCloudResourceExporter) updateSanitiseMap(exporters map[string]*resourceExporter.ResourceExporter, //Map of all of the exporters
 	resource resourceExporter.ResourceInfo) {
 	if exporters[resource.Type] != nil {
@@ -438,15 +614,33 @@ func (g *GenesysCloudResourceExporter) instanceStateToMap(state *terraform.Insta
 // generateOutputFiles is used to generate the tfStateFile and either the tf export or the json based export
 func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 	providerSource := g.sourceForVersion(g.version)
+	resourceModules := g.buildResourceModules()
 	if g.includeStateFile {
-		t := NewTFStateWriter(g.ctx, g.resources, g.d, providerSource)
+		t := NewTFStateWriter(g.getManagedResources(), g.d, providerSource, g.provider.ResourcesMap, resourceModules)
 		if err := t.writeTfState(); err != nil {
 			return err
 		}
 	}
 
+	if g.includeImportBlocks {
+		t := NewTFImportBlockWriter(g.getManagedResources(), g.exportDirPath, g.exportAsHCL, resourceModules)
+		if err := t.writeImportBlocks(); err != nil {
+			return err
+		}
+	}
+
+	if err := g.writeExportLock(resourceModules); err != nil {
+		return err
+	}
+
 	var err diag.Diagnostics
-	if g.exportAsHCL {
+	if g.moduleLayout != "" {
+		moduleExporter := NewModuleExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, resourceModules, providerSource, g.version, g.exportDirPath, g.exportAsHCL)
+		err = moduleExporter.exportModules()
+	} else if g.exportFormat == exportFormatCDKTF {
+		cdktfExporter := NewCdktfExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.provider.ResourcesMap, g.provider.DataSourcesMap, providerSource, g.version, g.exportDirPath, g.cdktfLanguage)
+		err = cdktfExporter.exportCdktfConfig()
+	} else if g.exportAsHCL {
 		hclExporter := NewHClExporter(g.resourceTypesHCLBlocks, g.unresolvedAttrs, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
 		err = hclExporter.exportHCLConfig()
 	} else {
@@ -458,6 +652,16 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 		return err
 	}
 
+	if err = g.writeEnvironmentTfVars(); err != nil {
+		return err
+	}
+
+	if g.addDependsOn {
+		if err = g.writeDependencyGraph(); err != nil {
+			return err
+		}
+	}
+
 	if g.cyclicDependsList != nil && len(g.cyclicDependsList) > 0 {
 		err = files.WriteToFile([]byte(strings.Join(g.cyclicDependsList, "\n")), filepath.Join(g.exportDirPath, "cyclicDepends.txt"))
 
@@ -469,6 +673,33 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 	return nil
 }
 
+// setupExportFormat resolves the export format from export_format or, when it is not set, from export_as_hcl
+func (g *GenesysCloudResourceExporter) setupExportFormat() diag.Diagnostics {
+	if g.exportFormat == "" {
+		g.exportFormat = exportFormatJSON
+		if g.exportAsHCL {
+			g.exportFormat = exportFormatHCL
+		}
+	}
+	g.exportAsHCL = g.exportFormat == exportFormatHCL
+
+	if g.exportFormat == exportFormatCDKTF && g.moduleLayout != "" {
+		return diag.Errorf("module_layout is not supported with the %s export format", exportFormatCDKTF)
+	}
+	return nil
+}
+
+// getManagedResources returns the exported resources that are not replaced with a data source
+func (g *GenesysCloudResourceExporter) getManagedResources() []resourceExporter.ResourceInfo {
+	managedResources := make([]resourceExporter.ResourceInfo, 0, len(g.resources))
+	for _, resource := range g.resources {
+		if !g.isDataSource(resource.Type, resource.Name) {
+			managedResources = append(managedResources, resource)
+		}
+	}
+	return managedResources
+}
+
 func (g *GenesysCloudResourceExporter) buildAndExportDependsOnResourcesForFlows() diag.Diagnostics {
 
 	if g.addDependsOn {
@@ -513,7 +744,7 @@ func (g *GenesysCloudResourceExporter) processAndBuildDependencies() (filters []
 			continue
 		}
 
-		resources, dependsStruct, err := proxy.GetAllWithPooledClient(retrieveDependentConsumers(resourceKeys))
+		resources, dependsStruct, err := proxy.GetAllWithPooledClient(g.ctx, retrieveDependentConsumers(resourceKeys))
 
 		g.flowResourcesList = append(g.flowResourcesList, resourceKeys.State.ID)
 
@@ -802,7 +1033,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 	errorChan := make(chan diag.Diagnostics)
 	wgDone := make(chan bool)
 	// Cancel remaining goroutines if an error occurs
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(g.ctx)
 	defer cancel()
 
 	var wg sync.WaitGroup
@@ -811,6 +1042,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 		go func(name string, exporter *resourceExporter.ResourceExporter) {
 			defer wg.Done()
 			log.Printf("Getting all resources for type %s", name)
+			g.report.typeStarted(name)
 			exporter.FilterResource = g.resourceFilter
 
 			err := exporter.LoadSanitizedResourceMap(ctx, name, filter)
@@ -821,6 +1053,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 			}
 			if containsPermissionsErrorOnly(err) && logErrors {
 				log.Printf("%v", err[0].Summary)
+				g.report.addSkipped(name, "", "", skipReason(err[0].Summary), err[0].Summary)
 				log.Print("log_permission_errors = true. Resuming export...")
 				return
 			}
@@ -942,69 +1175,101 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 	for id, resMeta := range exporter.SanitizedResourceMap {
 		go func(id string, resMeta *resourceExporter.ResourceMeta) {
 			defer wg.Done()
-			fetchResourceState := func() error {
+
+			// Incremental exports reuse the state of objects that have not changed since the previous export
+			if unchangedState := g.getUnchangedResourceState(resType, id, resMeta); unchangedState != nil {
+				resourceChan <- resourceExporter.ResourceInfo{
+					State:   unchangedState,
+					Name:    resMeta.Name,
+					Type:    resType,
+					CtyType: ctyType,
+				}
+				return
+			}
+
+			// Resumed exports reuse the state of objects read before the previous export failed
+			if checkpointState := g.getCheckpointedResourceState(resType, id); checkpointState != nil {
+				resourceChan <- resourceExporter.ResourceInfo{
+					State:   checkpointState,
+					Name:    resMeta.Name,
+					Type:    resType,
+					CtyType: ctyType,
+				}
+				return
+			}
+
+			fetchResourceState := func() diag.Diagnostics {
 				ctx, cancel := context.WithTimeout(context.Background(), time.Duration(30)*time.Minute)
 				defer cancel()
 				// This calls into the resource's ReadContext method which
 				// will block until it can acquire a pooled client config object.
 				instanceState, err := getResourceState(ctx, res, id, resMeta, meta)
 
-				if g.isDataSource(resType, resMeta.Name) {
-					g.exMutex.Lock()
-					res = provider.DataSourcesMap[resType]
-					g.exMutex.Unlock()
-
-					if res == nil {
-						return fmt.Errorf("DataSource type %v not defined", resType)
-					}
-
-					schemaMap := res.SchemaMap()
-
-					attributes := make(map[string]string)
-
-					for attr, _ := range schemaMap {
-						if value, ok := instanceState.Attributes[attr]; ok {
-							attributes[attr] = value
-						}
-					}
-					instanceState.Attributes = attributes
-				}
-
 				if err != nil {
-					errString := fmt.Sprintf("Failed to get state for %s instance %s: %v", resType, id, err)
-					return fmt.Errorf(errString)
+					return err
 				}
 
 				if instanceState == nil {
 					log.Printf("Resource %s no longer exists. Skipping.", resMeta.Name)
+					g.report.addSkipped(resType, id, resMeta.Name, skipReasonDeleted, "")
 					removeChan <- id // Mark for removal from the map
 					return nil
 				}
 
-				resourceChan <- resourceExporter.ResourceInfo{
+				if g.isDataSource(resType, resMeta.Name) {
+					g.exMutex.Lock()
+					dataSource := provider.DataSourcesMap[resType]
+					g.exMutex.Unlock()
+
+					if dataSource == nil {
+						return diag.Errorf("DataSource type %v not defined", resType)
+					}
+
+					// The object is replaced with a data block that looks it up
+					instanceState.Attributes = dataSourceAttributes(dataSource, instanceState.Attributes)
+				}
+
+				resourceInfo := resourceExporter.ResourceInfo{
 					State:   instanceState,
 					Name:    resMeta.Name,
 					Type:    resType,
 					CtyType: ctyType,
 				}
+				g.checkpoint.addResource(resType, id, resourceInfo)
+				resourceChan <- resourceInfo
 
 				return nil
 			}
 
-			isTimeoutError := func(err error) bool {
+			isTimeoutError := func(err diag.Diagnostics) bool {
 				return strings.Contains(fmt.Sprintf("%v", err), "timeout while waiting for state to become") ||
 					strings.Contains(fmt.Sprintf("%v", err), "context deadline exceeded")
 			}
 
-			var err error
-			for ok := true; ok; ok = isTimeoutError(err) {
-				err = fetchResourceState()
+			isThrottledError := func(err diag.Diagnostics) bool {
+				return util.GetDiagnosticsStatusCode(err) == http.StatusTooManyRequests
+			}
+
+			throttledRetries := 0
+			for {
+				g.scheduler.acquire(resType)
+				err := fetchResourceState()
+				g.scheduler.release()
 				if err == nil {
 					return
 				}
-				if !isTimeoutError(err) {
-					errorChan <- diag.Errorf("Failed to get state for %s instance %s: %v", resType, id, err)
+				if isTimeoutError(err) {
+					continue
+				}
+				if isThrottledError(err) && throttledRetries < maxThrottledReadRetries {
+					throttledRetries++
+					log.Printf("Read of %s instance %s was throttled. Retrying (%d/%d)", resType, id, throttledRetries, maxThrottledReadRetries)
+					continue
 				}
+				errString := fmt.Sprintf("Failed to get state for %s instance %s: %v", resType, id, err)
+				g.report.addSkipped(resType, id, resMeta.Name, skipReason(errString), errString)
+				errorChan <- diag.Errorf("%s", errString)
+				return
 			}
 		}(id, resMeta)
 	}
@@ -1165,6 +1430,7 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 				continue
 			}
 			configMap[key] = sanitizeE164Number(configMap[key].(string))
+			g.parameterizeAttribute(resourceType, resourceName, currAttr, key, configMap)
 			continue
 		}
 
@@ -1213,7 +1479,7 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 			}
 
 			if refSettings != nil {
-				configMap[key] = g.resolveReference(refSettings, val.(string), exporters, exportingState)
+				configMap[key] = g.resolveAttributeReference(resourceType, resourceName, currAttr, refSettings, val.(string), exporters, exportingState)
 			} else {
 				configMap[key] = escapeString(val.(string))
 			}
@@ -1222,7 +1488,13 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 			g.resolveValueToDataSource(exporter, configMap, currAttr, val)
 		}
 
-		if attr, ok := attrInUnResolvableAttrs(key, exporter.UnResolvableAttributes); ok {
+		if exporter.IsAttributeEnvironmentSpecific(currAttr) {
+			g.parameterizeAttribute(resourceType, resourceName, currAttr, key, configMap)
+		}
+
+		if g.secretReferenceStyle != "" && exporter.IsAttributeSecret(currAttr) {
+			g.referenceSecret(exporter, resourceType, resourceName, currAttr, key, configMap)
+		} else if attr, ok := attrInUnResolvableAttrs(key, exporter.UnResolvableAttributes); ok {
 			varReference := fmt.Sprintf("%s_%s_%s", resourceType, resourceName, key)
 			unresolvableAttrs = append(unresolvableAttrs, unresolvableAttributeInfo{
 				ResourceType: resourceType,
@@ -1318,12 +1590,15 @@ func (g *GenesysCloudResourceExporter) resolveValueToDataSource(exporter *resour
 	if !resolve {
 		return
 	}
+	g.addDataSource(dataSourceType, dataSourceId, dataSourceConfig)
+}
 
+// addDataSource adds a data source to the export if it hasn't already been added
+func (g *GenesysCloudResourceExporter) addDataSource(dataSourceType string, dataSourceId string, dataSourceConfig util.JsonMap) {
 	if g.dataSourceTypesMaps[dataSourceType] == nil {
 		g.dataSourceTypesMaps[dataSourceType] = make(resourceJSONMaps)
 	}
 
-	// add the data source to the export if it hasn't already been added
 	if _, ok := g.dataSourceTypesMaps[dataSourceType][dataSourceId]; ok {
 		return
 	}
@@ -1409,7 +1684,7 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigArray(
 			// Check if we are on a reference attribute and update value in array
 
 			if refSettings := exporter.GetRefAttrSettings(currAttr); refSettings != nil {
-				referenceVal := g.resolveReference(refSettings, val.(string), exporters, exportingState)
+				referenceVal := g.resolveAttributeReference(resourceType, resourceName, currAttr, refSettings, val.(string), exporters, exportingState)
 				if referenceVal != "" {
 					result = append(result, referenceVal)
 				}
@@ -1518,6 +1793,15 @@ func (g *GenesysCloudResourceExporter) resolveReference(refSettings *resourceExp
 	return ""
 }
 
+// resolveAttributeReference resolves a reference attribute of an exported resource and reports references to objects that were not exported
+func (g *GenesysCloudResourceExporter) resolveAttributeReference(resourceType string, resourceName string, currAttr string, refSettings *resourceExporter.RefAttrSettings, refID string, exporters map[string]*resourceExporter.ResourceExporter, exportingState bool) string {
+	reference := g.resolveReference(refSettings, refID, exporters, exportingState)
+	if refID != "" && !strings.HasPrefix(reference, "${") && !lists.ItemInSlice(refID, refSettings.AltValues) {
+		g.report.addUnresolvedReference(resourceType, resourceName, currAttr, refSettings.RefType, refID)
+	}
+	return reference
+}
+
 func (g *GenesysCloudResourceExporter) resourceIdExists(refID string, existingResources []resourceExporter.ResourceInfo) bool {
 	if g.addDependsOn {
 		if existingResources != nil {
@@ -1540,7 +1824,7 @@ func (g *GenesysCloudResourceExporter) resourceIdExists(refID string, existingRe
 
 func (g *GenesysCloudResourceExporter) isDataSource(resType string, name string) bool {
 	for _, element := range g.replaceWithDatasource {
-		if element == resType+"::"+name || fetchByRegex(element, resType, name) {
+		if element == resType || element == resType+"::"+name || fetchByRegex(element, resType, name) {
 			return true
 		}
 	}
@@ -277,7 +277,7 @@ func TestUnitTfExportBuildDependsOnResources(t *testing.T) {
 		return resources, dependencyStruct, nil
 	}
 
-	getAllPooledFn := func(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+	getAllPooledFn := func(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
 		//assert.Equal(t, targetName, name)
 		return resources, dependencyStruct, nil
 	}
@@ -360,6 +360,115 @@ func TestUnitTfExportFilterResourceById(t *testing.T) {
 	}
 }
 
+func TestUnitTfExportFilteredOutReferences(t *testing.T) {
+	resType := "genesyscloud_routing_queue"
+	queueResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":              {Type: schema.TypeString},
+			"division_id":       {Type: schema.TypeString},
+			"overflow_queue_id": {Type: schema.TypeString},
+		},
+	}
+	queue := func(id string, name string, divisionId string, overflowQueueId string) resourceExporter.ResourceInfo {
+		return resourceExporter.ResourceInfo{
+			Name: name,
+			Type: resType,
+			State: &terraform.InstanceState{ID: id, Attributes: map[string]string{
+				"name":              name,
+				"division_id":       divisionId,
+				"overflow_queue_id": overflowQueueId,
+			}},
+			CtyType: queueResource.CoreConfigSchema().ImpliedType(),
+		}
+	}
+
+	for _, exportingState := range []bool{false, true} {
+		exporter := &resourceExporter.ResourceExporter{
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"overflow_queue_id": {RefType: resType},
+			},
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+				"queue-1": {Name: "Support"},
+				"queue-2": {Name: "Sales"},
+			},
+		}
+		exporters := map[string]*resourceExporter.ResourceExporter{resType: exporter}
+		filterList := []string{resType + "::division_id==division-a"}
+		g := &GenesysCloudResourceExporter{
+			filterType:       IncludeResources,
+			filterList:       &filterList,
+			includeStateFile: exportingState,
+			exporters:        &exporters,
+		}
+
+		// The queue of the other division is filtered out and no longer resolves as a reference
+		g.resources = g.filterResourcesByAttributes(resType, exporter, []resourceExporter.ResourceInfo{
+			queue("queue-1", "Support", "division-a", "queue-2"),
+			queue("queue-2", "Sales", "division-b", ""),
+		})
+		assert.Len(t, g.resources, 1)
+		assert.NotContains(t, exporter.SanitizedResourceMap, "queue-2")
+
+		assert.Nil(t, g.buildResourceConfigMap())
+		support := g.resourceTypesMaps[resType]["Support"]
+		if exportingState {
+			assert.Equal(t, "queue-2", support["overflow_queue_id"])
+		} else {
+			assert.Nil(t, support["overflow_queue_id"])
+		}
+	}
+}
+
+func TestUnitTfExportFilterResourcesByAttributes(t *testing.T) {
+	resType := "genesyscloud_user"
+	resources := []resourceExporter.ResourceInfo{
+		{
+			Name: "user_active",
+			Type: resType,
+			State: &terraform.InstanceState{ID: "1", Attributes: map[string]string{
+				"state":                     "active",
+				"division_id":               "division-a",
+				"routing_skills.#":          "2",
+				"routing_skills.0.skill_id": "skill-1",
+				"routing_skills.1.skill_id": "skill-2",
+			}},
+		},
+		{
+			Name:  "user_inactive",
+			Type:  resType,
+			State: &terraform.InstanceState{ID: "2", Attributes: map[string]string{"state": "inactive", "division_id": "division-b"}},
+		},
+	}
+
+	names := func(resources []resourceExporter.ResourceInfo) []string {
+		result := make([]string, 0)
+		for _, resource := range resources {
+			result = append(result, resource.Name)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"user_active"}, names(FilterResourcesByAttributes(resources, resType, []string{"genesyscloud_user::state==active"}, true)))
+	assert.Equal(t, []string{"user_inactive"}, names(FilterResourcesByAttributes(resources, resType, []string{"genesyscloud_user::state==active"}, false)))
+	assert.Equal(t, []string{"user_inactive"}, names(FilterResourcesByAttributes(resources, resType, []string{"genesyscloud_user::division_id!=division-a"}, true)))
+	assert.Equal(t, []string{"user_active"}, names(FilterResourcesByAttributes(resources, resType, []string{"genesyscloud_user::routing_skills.skill_id==skill-2"}, true)))
+	assert.Equal(t, []string{"user_active", "user_inactive"}, names(FilterResourcesByAttributes(resources, resType, []string{"genesyscloud_user::division_id=~^division-(a|b)$"}, true)))
+
+	// Predicates are OR'ed for a resource type
+	filter := []string{"genesyscloud_user::division_id==division-a", "genesyscloud_user::state==inactive"}
+	assert.Len(t, FilterResourcesByAttributes(resources, resType, filter, true), 2)
+
+	// Predicates for other resource types and name filters are ignored
+	filter = []string{"genesyscloud_routing_queue::division_id==division-a", "genesyscloud_user::user_.*"}
+	assert.Len(t, FilterResourcesByAttributes(resources, resType, filter, true), 2)
+
+	// Name filters ignore attribute predicates
+	metaMap := resourceExporter.ResourceIDMetaMap{"1": {Name: "user_active"}, "2": {Name: "user_inactive"}}
+	assert.Len(t, IncludeFilterResourceByRegex(metaMap, resType, []string{"genesyscloud_user::state==active"}), 2)
+	assert.Len(t, ExcludeFilterResourceByRegex(metaMap, resType, []string{"genesyscloud_user::state==active"}), 2)
+	assert.Len(t, FilterResourceByName(metaMap, resType, []string{"genesyscloud_user::state==active"}), 2)
+}
+
 func TestUnitTfExportTestExcludeAttributes(t *testing.T) {
 
 	gre := &GenesysCloudResourceExporter{
@@ -533,3 +642,21 @@ func getMockCampaignConfig(originalValueOfScriptId string) map[string]any {
 
 	return config
 }
+
+func TestUnitTfExportUnresolvedAttrsReferenced(t *testing.T) {
+	unresolved := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_flow", ResourceName: "inbound", Name: "filepath"},
+		{ResourceType: "genesyscloud_flow", ResourceName: "inbound", Name: "substitutions"},
+	}
+
+	// A file writer that failed leaves the variables in place
+	configMap := map[string]interface{}{
+		"filepath":      "${var.genesyscloud_flow_inbound_filepath}",
+		"substitutions": "${var.genesyscloud_flow_inbound_substitutions}",
+	}
+	assert.Equal(t, unresolved, unresolvedAttrsReferenced(unresolved, configMap))
+
+	// Attributes set by the file writer no longer need a variable
+	configMap["filepath"] = "flows/flow-1234.yaml"
+	assert.Equal(t, unresolved[1:], unresolvedAttrsReferenced(unresolved, configMap))
+}
@@ -94,29 +94,32 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 	}
 
 	// Optional tfvars file creation for unresolved attributes
-	if len(h.unresolvedAttrs) > 0 {
-		tfVars := make(map[string]interface{})
-		keys := make(map[string]string)
-		for _, attr := range h.unresolvedAttrs {
-			key := createUnresolvedAttrKey(attr)
-			if keys[key] != "" {
-				continue
-			}
-			keys[key] = key
+	return writeUnresolvedAttrsTfVars(h.unresolvedAttrs, h.dirPath)
+}
 
-			tfVars[key] = determineVarValue(attr.Schema)
+// Write a tfvars file with default values for the unresolved attributes. Parameterized attributes are set by the environment tfvars templates instead.
+func writeUnresolvedAttrsTfVars(unresolvedAttrs []unresolvableAttributeInfo, dirPath string) diag.Diagnostics {
+	tfVars := make(map[string]interface{})
+	keys := make(map[string]string)
+	for _, attr := range unresolvedAttrs {
+		key := createUnresolvedAttrKey(attr)
+		if keys[key] != "" || attr.Parameterized {
+			continue
 		}
+		keys[key] = key
 
-		tfVarsFilePath := filepath.Join(h.dirPath, defaultTfVarsFile)
-		if tfVarsFilePath == "" {
-			return diag.Errorf("Failed to create tfvars file path %s", tfVarsFilePath)
-		}
-		if diagErr := writeTfVars(tfVars, tfVarsFilePath); diagErr != nil {
-			return diagErr
-		}
+		tfVars[key] = determineVarValue(attr.Schema)
 	}
 
-	return nil
+	if len(tfVars) == 0 {
+		return nil
+	}
+
+	tfVarsFilePath := filepath.Join(dirPath, defaultTfVarsFile)
+	if tfVarsFilePath == "" {
+		return diag.Errorf("Failed to create tfvars file path %s", tfVarsFilePath)
+	}
+	return writeTfVars(tfVars, tfVarsFilePath)
 }
 
 // Create the  HCL block for terraform and the genesyscloud provider
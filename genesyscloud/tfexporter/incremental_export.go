@@ -0,0 +1,179 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains all of the logic used for incremental exports. At the end of an incremental export a manifest is written to the export
+directory holding the version and state of every exported object. The next incremental export still enumerates all objects, but only reads
+the objects that are new or whose version has changed since the previous export. Objects that no longer exist are dropped from the output.
+*/
+
+const exportManifestFormatVersion = 1
+
+type exportManifest struct {
+	FormatVersion int `json:"format_version"`
+
+	// Resource type -> object ID -> entry
+	Resources map[string]map[string]*exportManifestEntry `json:"resources"`
+}
+
+type exportManifestEntry struct {
+	Name       string                 `json:"name"`
+	Version    string                 `json:"version,omitempty"`
+	StateId    string                 `json:"state_id"`
+	Attributes map[string]string      `json:"attributes"`
+	Meta       map[string]interface{} `json:"meta,omitempty"`
+}
+
+func newExportManifest() *exportManifest {
+	return &exportManifest{
+		FormatVersion: exportManifestFormatVersion,
+		Resources:     make(map[string]map[string]*exportManifestEntry),
+	}
+}
+
+// readExportManifest reads the manifest of a previous incremental export. A nil manifest is returned if the file does not exist.
+func readExportManifest(path string) (*exportManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	manifest := newExportManifest()
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+
+	if manifest.FormatVersion != exportManifestFormatVersion {
+		log.Printf("Ignoring export manifest %s with unsupported format version %d", path, manifest.FormatVersion)
+		return nil, nil
+	}
+	return manifest, nil
+}
+
+// unchangedState returns the state stored for an object in the manifest if its version matches the version returned by the current enumeration
+func (m *exportManifest) unchangedState(resType string, id string, resMeta *resourceExporter.ResourceMeta) *terraform.InstanceState {
+	if m == nil || resMeta == nil || resMeta.Version == "" {
+		return nil
+	}
+
+	entry, ok := m.Resources[resType][id]
+	if !ok || entry.Version != resMeta.Version {
+		return nil
+	}
+
+	return &terraform.InstanceState{
+		ID:         entry.StateId,
+		Attributes: copyStringMap(entry.Attributes),
+		Meta:       entry.Meta,
+	}
+}
+
+func (m *exportManifest) addResource(resType string, id string, version string, resource resourceExporter.ResourceInfo) {
+	if m.Resources[resType] == nil {
+		m.Resources[resType] = make(map[string]*exportManifestEntry)
+	}
+	m.Resources[resType][id] = &exportManifestEntry{
+		Name:       resource.Name,
+		Version:    version,
+		StateId:    resource.State.ID,
+		Attributes: resource.State.Attributes,
+		Meta:       resource.State.Meta,
+	}
+}
+
+// loadExportManifest reads the manifest left in the export directory by a previous incremental export
+func (g *GenesysCloudResourceExporter) loadExportManifest() diag.Diagnostics {
+	if !g.incrementalExport {
+		return nil
+	}
+
+	manifestPath := filepath.Join(g.exportDirPath, defaultExportManifestFile)
+	manifest, err := readExportManifest(manifestPath)
+	if err != nil {
+		return diag.Errorf("Failed to read export manifest %s: %v", manifestPath, err)
+	}
+
+	if manifest == nil {
+		log.Printf("No previous export manifest found in %s. Running a full export.", g.exportDirPath)
+		return nil
+	}
+
+	g.previousManifest = manifest
+	return nil
+}
+
+// getUnchangedResourceState returns the previously exported state of an object if it has not changed since the last incremental export
+func (g *GenesysCloudResourceExporter) getUnchangedResourceState(resType string, id string, resMeta *resourceExporter.ResourceMeta) *terraform.InstanceState {
+	if !g.incrementalExport || g.isDataSource(resType, resMeta.Name) {
+		return nil
+	}
+	return g.previousManifest.unchangedState(resType, id, resMeta)
+}
+
+// writeExportManifest records the version and state of every exported object for the next incremental export
+func (g *GenesysCloudResourceExporter) writeExportManifest() diag.Diagnostics {
+	if !g.incrementalExport {
+		return nil
+	}
+
+	manifest := newExportManifest()
+
+	// The state ID may include an ID prefix so build a lookup back to the ID returned by the exporter
+	objectIds := make(map[string]map[string]string)
+	for resType, exporter := range *g.exporters {
+		objectIds[resType] = make(map[string]string)
+		for id, meta := range exporter.SanitizedResourceMap {
+			objectIds[resType][meta.IdPrefix+id] = id
+		}
+	}
+
+	for _, resource := range g.resources {
+		if g.isDataSource(resource.Type, resource.Name) {
+			continue
+		}
+
+		id, ok := objectIds[resource.Type][resource.State.ID]
+		if !ok {
+			id = resource.State.ID
+		}
+
+		version := ""
+		if exporter, ok := (*g.exporters)[resource.Type]; ok {
+			if meta := exporter.SanitizedResourceMap[id]; meta != nil {
+				version = meta.Version
+			}
+		}
+		manifest.addResource(resource.Type, id, version, resource)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode export manifest as JSON: %v", err)
+	}
+
+	manifestPath := filepath.Join(g.exportDirPath, defaultExportManifestFile)
+	log.Printf("Writing export manifest to %s", manifestPath)
+	return files.WriteToPrivateFile(data, manifestPath)
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
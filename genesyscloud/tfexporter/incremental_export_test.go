@@ -0,0 +1,78 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportIncrementalManifest(t *testing.T) {
+	exportDir := t.TempDir()
+	resType := "genesyscloud_routing_queue"
+
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		resType: {
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+				"queue-1": {Name: "queue_1", Version: "2"},
+				"queue-2": {Name: "queue_2"},
+			},
+		},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		incrementalExport: true,
+		exportDirPath:     exportDir,
+		exporters:         &exporters,
+		resources: []resourceExporter.ResourceInfo{
+			{
+				Name:  "queue_1",
+				Type:  resType,
+				State: &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{"id": "queue-1", "name": "queue 1"}},
+			},
+			{
+				Name:  "queue_2",
+				Type:  resType,
+				State: &terraform.InstanceState{ID: "queue-2", Attributes: map[string]string{"id": "queue-2", "name": "queue 2"}},
+			},
+		},
+	}
+
+	diagErr := g.writeExportManifest()
+	assert.Nil(t, diagErr)
+
+	// The manifest holds object state so only the current user can read it
+	info, err := os.Stat(filepath.Join(exportDir, defaultExportManifestFile))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	manifest, err := readExportManifest(filepath.Join(exportDir, defaultExportManifestFile))
+	assert.Nil(t, err)
+	assert.NotNil(t, manifest)
+	assert.Len(t, manifest.Resources[resType], 2)
+
+	next := &GenesysCloudResourceExporter{incrementalExport: true, previousManifest: manifest}
+
+	// Same version reuses the previous state
+	state := next.getUnchangedResourceState(resType, "queue-1", &resourceExporter.ResourceMeta{Name: "queue_1", Version: "2"})
+	assert.NotNil(t, state)
+	assert.Equal(t, "queue-1", state.ID)
+	assert.Equal(t, "queue 1", state.Attributes["name"])
+
+	// Changed version is read again
+	assert.Nil(t, next.getUnchangedResourceState(resType, "queue-1", &resourceExporter.ResourceMeta{Name: "queue_1", Version: "3"}))
+
+	// Objects without a version are always read
+	assert.Nil(t, next.getUnchangedResourceState(resType, "queue-2", &resourceExporter.ResourceMeta{Name: "queue_2"}))
+
+	// New objects are read
+	assert.Nil(t, next.getUnchangedResourceState(resType, "queue-3", &resourceExporter.ResourceMeta{Name: "queue_3", Version: "1"}))
+
+	// A missing manifest results in a full export
+	missing, err := readExportManifest(filepath.Join(t.TempDir(), defaultExportManifestFile))
+	assert.Nil(t, err)
+	assert.Nil(t, missing)
+}
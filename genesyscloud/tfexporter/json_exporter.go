@@ -129,14 +129,17 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 	}
 
 	// Optional tfvars file creation for unresolved attributes
-	if len(j.unresolvedAttrs) > 0 {
-		tfVars := make(map[string]interface{})
-		for _, attr := range j.unresolvedAttrs {
-			key := createUnresolvedAttrKey(attr)
-			tfVars[key] = make(util.JsonMap)
-			tfVars[key] = determineVarValue(attr.Schema)
+	tfVars := make(map[string]interface{})
+	for _, attr := range j.unresolvedAttrs {
+		if attr.Parameterized {
+			continue
 		}
+		key := createUnresolvedAttrKey(attr)
+		tfVars[key] = make(util.JsonMap)
+		tfVars[key] = determineVarValue(attr.Schema)
+	}
 
+	if len(tfVars) > 0 {
 		tfVarsFilePath := filepath.Join(j.dirPath, defaultTfVarsFile)
 		if tfVarsFilePath == "" {
 			return diag.Errorf("Failed to create tfvars file path %s", tfVarsFilePath)
@@ -0,0 +1,604 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains all of the code used to export the resources into reusable child modules. Resources are grouped either by the
+division they belong to or by their functional domain. Each group is written to its own module under the 'modules' directory and the
+root module calls every child module. References between modules are wired through generated output and variable blocks.
+Resources that do not belong to any group, data sources and the provider requirements remain in the root module. A depends_on entry
+for a resource in another module is moved to the module call in the root module, unless it would create a cycle between modules.
+*/
+
+const (
+	moduleLayoutDivision = "division"
+	moduleLayoutDomain   = "domain"
+
+	defaultModulesDirectory = "modules"
+)
+
+// Resource type prefixes used to assign resources to a domain module
+var moduleDomainResourcePrefixes = map[string][]string{
+	"architect": {"genesyscloud_architect_", "genesyscloud_flow"},
+	"routing":   {"genesyscloud_routing_"},
+	"telephony": {"genesyscloud_telephony_"},
+	"outbound":  {"genesyscloud_outbound_"},
+}
+
+var (
+	// ${type.name.attr} or ${data.type.name.attr}
+	moduleReferenceRegex = regexp.MustCompile(`\$\{(data\.)?([A-Za-z0-9_-]+)\.([A-Za-z0-9_-]+)\.([A-Za-z0-9_]+)\}`)
+	moduleVariableRegex  = regexp.MustCompile(`\bvar\.([A-Za-z0-9_-]+)`)
+)
+
+type ModuleExporter struct {
+	resourceTypesMaps   map[string]resourceJSONMaps
+	dataSourceTypesMaps map[string]resourceJSONMaps
+	unresolvedAttrs     []unresolvableAttributeInfo
+	resourceModules     map[string]string
+	providerSource      string
+	version             string
+	dirPath             string
+	exportAsHCL         bool
+	modules             map[string]*exportModule
+
+	// Dependencies between child modules and root module resources through references and depends_on entries. A child module is
+	// identified by its name and a root module resource by its address.
+	moduleEdges map[string]map[string]bool
+
+	// Address of the resource whose references are being rewritten
+	rewriteAddress string
+}
+
+type exportModule struct {
+	name      string
+	resources map[string]resourceJSONMaps
+
+	// Variable name -> expression passed in by the root module
+	inputs map[string]string
+
+	// Output name -> expression
+	outputs map[string]string
+
+	// Variable name -> address of the referenced value outside of the module
+	references map[string]string
+
+	// Addresses of the modules and root module resources the module call depends on
+	dependsOn map[string]bool
+}
+
+// NewModuleExporter creates an exporter for the module layout. resourceModules maps a resource address to the name of the
+// module the resource is written to. Resources not found in the map are kept in the root module.
+func NewModuleExporter(resourceTypesMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, resourceModules map[string]string, providerSource string, version string, dirPath string, exportAsHCL bool) *ModuleExporter {
+	return &ModuleExporter{
+		resourceTypesMaps:   resourceTypesMaps,
+		dataSourceTypesMaps: dataSourceTypesMaps,
+		unresolvedAttrs:     unresolvedAttrs,
+		resourceModules:     resourceModules,
+		providerSource:      providerSource,
+		version:             version,
+		dirPath:             dirPath,
+		exportAsHCL:         exportAsHCL,
+		modules:             make(map[string]*exportModule),
+		moduleEdges:         make(map[string]map[string]bool),
+	}
+}
+
+func (m *ModuleExporter) exportModules() diag.Diagnostics {
+	m.buildModules()
+
+	for _, name := range m.moduleNames() {
+		module := m.modules[name]
+		if name == "" {
+			continue
+		}
+		moduleDir := filepath.Join(m.dirPath, defaultModulesDirectory, name)
+		if err := os.MkdirAll(moduleDir, os.ModePerm); err != nil {
+			return diag.Errorf("Failed to create module directory %s: %v", moduleDir, err)
+		}
+
+		var diagErr diag.Diagnostics
+		if m.exportAsHCL {
+			diagErr = writeHCLToFile(m.childModuleHCLBlocks(module), filepath.Join(moduleDir, defaultTfHCLFile))
+		} else {
+			diagErr = writeConfig(m.childModuleJsonMap(module), filepath.Join(moduleDir, defaultTfJSONFile))
+		}
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	var diagErr diag.Diagnostics
+	if m.exportAsHCL {
+		diagErr = writeHCLToFile(m.rootModuleHCLBlocks(), filepath.Join(m.dirPath, defaultTfHCLFile))
+	} else {
+		diagErr = writeConfig(m.rootModuleJsonMap(), filepath.Join(m.dirPath, defaultTfJSONFile))
+	}
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return writeUnresolvedAttrsTfVars(m.unresolvedAttrs, m.dirPath)
+}
+
+// buildModules assigns every resource to its module and rewrites references that cross a module boundary
+func (m *ModuleExporter) buildModules() {
+	m.getModule("")
+	for resType, resMaps := range m.resourceTypesMaps {
+		for resName, config := range resMaps {
+			module := m.getModule(m.resourceModules[resType+"."+resName])
+			if module.resources[resType] == nil {
+				module.resources[resType] = make(resourceJSONMaps)
+			}
+			module.resources[resType][resName] = config
+		}
+	}
+
+	for _, name := range m.moduleNames() {
+		module := m.modules[name]
+		for resType, resMaps := range module.resources {
+			for resName, config := range resMaps {
+				m.rewriteAddress = resType + "." + resName
+				m.rewriteReferences(module, config)
+			}
+		}
+	}
+
+	// Data sources are kept in the root module
+	for dataSourceType, resMaps := range m.dataSourceTypesMaps {
+		for resName, config := range resMaps {
+			m.rewriteAddress = "data." + dataSourceType + "." + resName
+			m.rewriteReferences(m.modules[""], config)
+		}
+	}
+
+	// depends_on entries are resolved once every reference between modules is known, so they do not create cycles
+	for _, name := range m.moduleNames() {
+		module := m.modules[name]
+		for _, resType := range sortedKeys(module.resources) {
+			for _, resName := range sortedKeys(module.resources[resType]) {
+				m.resolveDependsOn(module, resType+"."+resName, module.resources[resType][resName])
+			}
+		}
+	}
+	for _, dataSourceType := range sortedKeys(m.dataSourceTypesMaps) {
+		for _, resName := range sortedKeys(m.dataSourceTypesMaps[dataSourceType]) {
+			m.resolveDependsOn(m.modules[""], "data."+dataSourceType+"."+resName, m.dataSourceTypesMaps[dataSourceType][resName])
+		}
+	}
+}
+
+func (m *ModuleExporter) getModule(name string) *exportModule {
+	if module, ok := m.modules[name]; ok {
+		return module
+	}
+	module := &exportModule{
+		name:       name,
+		resources:  make(map[string]resourceJSONMaps),
+		inputs:     make(map[string]string),
+		outputs:    make(map[string]string),
+		references: make(map[string]string),
+		dependsOn:  make(map[string]bool),
+	}
+	m.modules[name] = module
+	return module
+}
+
+// moduleNames returns the module names in a stable order. The root module has an empty name and is always first.
+func (m *ModuleExporter) moduleNames() []string {
+	names := make([]string, 0, len(m.modules))
+	for name := range m.modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *ModuleExporter) rewriteReferences(module *exportModule, value interface{}) interface{} {
+	switch v := value.(type) {
+	case util.JsonMap:
+		m.rewriteMapReferences(module, v)
+	case map[string]interface{}:
+		m.rewriteMapReferences(module, v)
+	case []interface{}:
+		for i, item := range v {
+			v[i] = m.rewriteReferences(module, item)
+		}
+	case []string:
+		for i, item := range v {
+			v[i] = m.rewriteReferences(module, item).(string)
+		}
+	case string:
+		// jsonencode attributes are stored as placeholders so the decoded value needs to be rewritten instead
+		if decoded, ok := attributesDecoded[v]; ok {
+			attributesDecoded[v] = m.rewriteString(module, decoded)
+			return v
+		}
+		return m.rewriteString(module, v)
+	}
+	return value
+}
+
+func (m *ModuleExporter) rewriteMapReferences(module *exportModule, configMap map[string]interface{}) {
+	for key, val := range configMap {
+		if key == "depends_on" {
+			continue
+		}
+		configMap[key] = m.rewriteReferences(module, val)
+	}
+}
+
+// resolveDependsOn keeps the depends_on entries of a resource for resources in the same module. An entry for a resource in another
+// module becomes a depends_on of the child module call, or of the root module resource on the other module. Entries that would
+// create a cycle between modules are dropped.
+func (m *ModuleExporter) resolveDependsOn(module *exportModule, resourceAddress string, configMap map[string]interface{}) {
+	var entries []string
+	switch v := configMap["depends_on"].(type) {
+	case []string:
+		entries = v
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				entries = append(entries, s)
+			}
+		}
+	default:
+		return
+	}
+
+	dependsOn := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		address := strings.TrimSuffix(strings.TrimPrefix(entry, "$dep$"), "$dep$")
+		targetModule := m.resourceModules[address]
+		from, to := moduleNode(module.name, resourceAddress), moduleNode(targetModule, address)
+		if targetModule == module.name {
+			m.addModuleEdge(from, to)
+			dependsOn = append(dependsOn, entry)
+			continue
+		}
+		if m.moduleDependsOn(to, from) {
+			log.Printf("Dropping depends_on %s of %s as it would create a cycle between modules", address, resourceAddress)
+			continue
+		}
+		m.addModuleEdge(from, to)
+
+		switch {
+		case module.name == "":
+			if moduleEntry := fmt.Sprintf("$dep$module.%s$dep$", targetModule); !lists.ItemInSlice(moduleEntry, dependsOn) {
+				dependsOn = append(dependsOn, moduleEntry)
+			}
+		case targetModule == "":
+			module.dependsOn[address] = true
+		default:
+			module.dependsOn["module."+targetModule] = true
+		}
+	}
+
+	if len(dependsOn) > 0 {
+		configMap["depends_on"] = dependsOn
+	} else {
+		delete(configMap, "depends_on")
+	}
+}
+
+func (m *ModuleExporter) addModuleEdge(from string, to string) {
+	if from == to {
+		return
+	}
+	if m.moduleEdges[from] == nil {
+		m.moduleEdges[from] = make(map[string]bool)
+	}
+	m.moduleEdges[from][to] = true
+}
+
+// moduleNode returns the node of a resource in the dependency graph between modules. All resources of a child module share the
+// node of the module.
+func moduleNode(moduleName string, address string) string {
+	if moduleName != "" {
+		return moduleName
+	}
+	return address
+}
+
+// moduleDependsOn reports whether a node depends on another node through references or depends_on entries
+func (m *ModuleExporter) moduleDependsOn(from string, to string) bool {
+	visited := map[string]bool{from: true}
+	pending := []string{from}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		if current == to {
+			return true
+		}
+		for next := range m.moduleEdges[current] {
+			if !visited[next] {
+				visited[next] = true
+				pending = append(pending, next)
+			}
+		}
+	}
+	return false
+}
+
+// moduleDependsOnEntries returns the depends_on entries of a module call
+func moduleDependsOnEntries(module *exportModule) []string {
+	entries := make([]string, 0, len(module.dependsOn))
+	for _, address := range sortedKeys(module.dependsOn) {
+		entries = append(entries, fmt.Sprintf("$dep$%s$dep$", address))
+	}
+	return entries
+}
+
+func (m *ModuleExporter) rewriteString(module *exportModule, value string) string {
+	if module.name != "" {
+		// Variables of the root module are passed through to the child module under the same name
+		for _, match := range moduleVariableRegex.FindAllStringSubmatch(value, -1) {
+			module.inputs[match[1]] = fmt.Sprintf("${var.%s}", match[1])
+			module.references[match[1]] = "var." + match[1]
+		}
+	}
+
+	return moduleReferenceRegex.ReplaceAllStringFunc(value, func(match string) string {
+		parts := moduleReferenceRegex.FindStringSubmatch(match)
+		isDataSource, resType, resName, attr := parts[1] != "", parts[2], parts[3], parts[4]
+		if !isDataSource && (resType == "var" || resType == "module" || resType == "local") {
+			return match
+		}
+		return m.resolveReference(module, isDataSource, resType, resName, attr, match)
+	})
+}
+
+// resolveReference returns the expression used by a module to reference an attribute of a resource or data source
+func (m *ModuleExporter) resolveReference(module *exportModule, isDataSource bool, resType string, resName string, attr string, match string) string {
+	address := resType + "." + resName
+	targetModule := ""
+	if isDataSource {
+		address = "data." + address
+	} else {
+		targetModule = m.resourceModules[address]
+	}
+
+	m.addModuleEdge(moduleNode(module.name, m.rewriteAddress), moduleNode(targetModule, address))
+	if targetModule == module.name {
+		return match
+	}
+
+	expression := address + "." + attr
+	valueName := strings.ReplaceAll(expression, ".", "_")
+
+	rootExpression := fmt.Sprintf("${%s}", expression)
+	if targetModule != "" {
+		m.getModule(targetModule).outputs[valueName] = rootExpression
+		rootExpression = fmt.Sprintf("${module.%s.%s}", targetModule, valueName)
+	}
+
+	if module.name == "" {
+		return rootExpression
+	}
+	module.inputs[valueName] = rootExpression
+	module.references[valueName] = expression
+	return fmt.Sprintf("${var.%s}", valueName)
+}
+
+// moduleVariables returns the unresolved attributes passed into a module and the names of the remaining module inputs
+func (m *ModuleExporter) moduleVariables(module *exportModule) ([]unresolvableAttributeInfo, []string) {
+	var (
+		unresolvedAttrs []unresolvableAttributeInfo
+		references      []string
+		keys            = make(map[string]bool)
+	)
+	for _, attr := range m.unresolvedAttrs {
+		key := createUnresolvedAttrKey(attr)
+		if _, ok := module.inputs[key]; ok && !keys[key] {
+			keys[key] = true
+			unresolvedAttrs = append(unresolvedAttrs, attr)
+		}
+	}
+	for _, name := range sortedKeys(module.references) {
+		if !keys[name] {
+			references = append(references, name)
+		}
+	}
+	return unresolvedAttrs, references
+}
+
+func (m *ModuleExporter) childModuleHCLBlocks(module *exportModule) [][]byte {
+	blocks := [][]byte{createHCLProviderBlock(m.providerSource, m.version)}
+	blocks = append(blocks, createModuleResourceHCLBlocks(module.resources, false)...)
+
+	unresolvedAttrs, references := m.moduleVariables(module)
+	blocks = append(blocks, createHCLVariablesBlock(unresolvedAttrs))
+
+	f := hclwrite.NewEmptyFile()
+	for _, name := range references {
+		variableBlock := f.Body().AppendNewBlock("variable", []string{name})
+		variableBlock.Body().SetAttributeValue("description", zclconfCty.StringVal(moduleInputDescription(module.references[name])))
+		f.Body().AppendNewline()
+	}
+	for _, name := range sortedKeys(module.outputs) {
+		outputBlock := f.Body().AppendNewBlock("output", []string{name})
+		outputBlock.Body().SetAttributeValue("value", zclconfCty.StringVal(module.outputs[name]))
+		f.Body().AppendNewline()
+	}
+	blocks = append(blocks, []byte(strings.Replace(string(f.Bytes()), "$${", "${", -1)))
+	return blocks
+}
+
+func (m *ModuleExporter) rootModuleHCLBlocks() [][]byte {
+	root := m.modules[""]
+	blocks := [][]byte{createHCLProviderBlock(m.providerSource, m.version)}
+	blocks = append(blocks, createModuleResourceHCLBlocks(root.resources, false)...)
+	blocks = append(blocks, createModuleResourceHCLBlocks(m.dataSourceTypesMaps, true)...)
+
+	f := hclwrite.NewEmptyFile()
+	for _, name := range m.moduleNames() {
+		if name == "" {
+			continue
+		}
+		moduleBody := f.Body().AppendNewBlock("module", []string{name}).Body()
+		moduleBody.SetAttributeValue("source", zclconfCty.StringVal(moduleSource(name)))
+		inputs := m.modules[name].inputs
+		for _, input := range sortedKeys(inputs) {
+			moduleBody.SetAttributeValue(input, zclconfCty.StringVal(inputs[input]))
+		}
+		if dependsOn := moduleDependsOnEntries(m.modules[name]); len(dependsOn) > 0 {
+			values := make([]zclconfCty.Value, 0, len(dependsOn))
+			for _, entry := range dependsOn {
+				values = append(values, zclconfCty.StringVal(entry))
+			}
+			moduleBody.SetAttributeValue("depends_on", zclconfCty.ListVal(values))
+		}
+		f.Body().AppendNewline()
+	}
+	blocks = append(blocks, []byte(strings.Replace(string(f.Bytes()), "$${", "${", -1)))
+	blocks = append(blocks, createHCLVariablesBlock(m.unresolvedAttrs))
+	return blocks
+}
+
+func (m *ModuleExporter) childModuleJsonMap(module *exportModule) util.JsonMap {
+	rootJSONObject := util.JsonMap{
+		"terraform": createProviderJsonMap(m.providerSource, m.version),
+		"resource":  module.resources,
+	}
+
+	unresolvedAttrs, references := m.moduleVariables(module)
+	variables := createVariablesJsonMap(unresolvedAttrs)
+	for _, name := range references {
+		variables[name] = util.JsonMap{"description": moduleInputDescription(module.references[name])}
+	}
+	if len(variables) > 0 {
+		rootJSONObject["variable"] = variables
+	}
+
+	if len(module.outputs) > 0 {
+		outputs := make(map[string]util.JsonMap)
+		for name, value := range module.outputs {
+			outputs[name] = util.JsonMap{"value": value}
+		}
+		rootJSONObject["output"] = outputs
+	}
+	return rootJSONObject
+}
+
+func (m *ModuleExporter) rootModuleJsonMap() util.JsonMap {
+	rootJSONObject := util.JsonMap{
+		"terraform": createProviderJsonMap(m.providerSource, m.version),
+		"resource":  m.modules[""].resources,
+		"data":      m.dataSourceTypesMaps,
+	}
+
+	modules := make(map[string]util.JsonMap)
+	for name, module := range m.modules {
+		if name == "" {
+			continue
+		}
+		moduleMap := util.JsonMap{"source": moduleSource(name)}
+		for input, value := range module.inputs {
+			moduleMap[input] = value
+		}
+		if dependsOn := moduleDependsOnEntries(module); len(dependsOn) > 0 {
+			moduleMap["depends_on"] = dependsOn
+		}
+		modules[name] = moduleMap
+	}
+	if len(modules) > 0 {
+		rootJSONObject["module"] = modules
+	}
+
+	if variablesJsonMap := createVariablesJsonMap(m.unresolvedAttrs); len(variablesJsonMap) > 0 {
+		rootJSONObject["variable"] = variablesJsonMap
+	}
+	return rootJSONObject
+}
+
+func createModuleResourceHCLBlocks(resourceMaps map[string]resourceJSONMaps, isDataSource bool) [][]byte {
+	var blocks [][]byte
+	for _, resType := range sortedKeys(resourceMaps) {
+		for _, resName := range sortedKeys(resourceMaps[resType]) {
+			blocks = append(blocks, instanceStateToHCLBlock(resType, resName, resourceMaps[resType][resName], isDataSource))
+		}
+	}
+	return blocks
+}
+
+func moduleSource(name string) string {
+	return "./" + defaultModulesDirectory + "/" + name
+}
+
+func moduleInputDescription(reference string) string {
+	return fmt.Sprintf("Value of %s passed in from the root module", reference)
+}
+
+// buildResourceModules maps the address of every managed resource to the child module it is exported to
+func (g *GenesysCloudResourceExporter) buildResourceModules() map[string]string {
+	resourceModules := make(map[string]string)
+	if g.moduleLayout == "" {
+		return resourceModules
+	}
+
+	for _, resource := range g.getManagedResources() {
+		var module string
+		switch g.moduleLayout {
+		case moduleLayoutDivision:
+			if resource.State != nil {
+				module = g.divisionModuleName(resource.State.Attributes["division_id"])
+			}
+		case moduleLayoutDomain:
+			module = domainModuleName(resource.Type)
+		}
+		if module != "" {
+			resourceModules[resource.Type+"."+resource.Name] = module
+		}
+	}
+	return resourceModules
+}
+
+// divisionModuleName returns the name of the module for a division. The exported name of the division is used when divisions are exported.
+func (g *GenesysCloudResourceExporter) divisionModuleName(divisionId string) string {
+	if divisionId == "" {
+		return ""
+	}
+	if g.exporters != nil {
+		if exporter, ok := (*g.exporters)["genesyscloud_auth_division"]; ok {
+			if meta := exporter.SanitizedResourceMap[divisionId]; meta != nil && meta.Name != "" {
+				return meta.Name
+			}
+		}
+	}
+	return "division_" + divisionId
+}
+
+// domainModuleName returns the domain module a resource type is exported to or an empty string for the root module
+func domainModuleName(resType string) string {
+	for domain, prefixes := range moduleDomainResourcePrefixes {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(resType, prefix) {
+				return domain
+			}
+		}
+	}
+	return ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
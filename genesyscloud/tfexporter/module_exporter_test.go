@@ -0,0 +1,141 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportModuleLayout(t *testing.T) {
+	resourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_auth_division": {
+			"division_a": util.JsonMap{"name": "Division A"},
+		},
+		"genesyscloud_routing_queue": {
+			"queue_a": util.JsonMap{
+				"name":        "Queue A",
+				"division_id": "${genesyscloud_auth_division.division_a.id}",
+				// The architect module references the routing module, so this entry would create a cycle
+				"depends_on": []string{"$dep$genesyscloud_flow.flow_a$dep$"},
+			},
+		},
+		"genesyscloud_user": {
+			"user_a": util.JsonMap{
+				"email":      "user_a@example.com",
+				"depends_on": []string{"$dep$genesyscloud_routing_queue.queue_a$dep$"},
+			},
+		},
+		"genesyscloud_flow": {
+			"flow_a": util.JsonMap{
+				"filepath":   "flows/flow-a.yaml",
+				"queue_ids":  []interface{}{"${genesyscloud_routing_queue.queue_a.id}"},
+				"site_id":    "${var.genesyscloud_flow_flow_a_site_id}",
+				"depends_on": []string{"$dep$genesyscloud_routing_queue.queue_a$dep$"},
+			},
+		},
+	}
+	unresolvedAttrs := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_flow", ResourceName: "flow_a", Name: "site_id", Schema: &schema.Schema{Type: schema.TypeString}},
+	}
+	resourceModules := map[string]string{
+		"genesyscloud_routing_queue.queue_a": "routing",
+		"genesyscloud_flow.flow_a":           "architect",
+	}
+
+	exportDir := t.TempDir()
+	m := NewModuleExporter(resourceTypesMaps, map[string]resourceJSONMaps{}, unresolvedAttrs, resourceModules, "mypurecloud/genesyscloud", "0.1.0", exportDir, false)
+	diagErr := m.exportModules()
+	assert.Nil(t, diagErr)
+
+	// Root module keeps the division and calls the child modules
+	root, err := loadJsonFileToMap(filepath.Join(exportDir, defaultTfJSONFile))
+	assert.Nil(t, err)
+	rootResources := root["resource"].(map[string]interface{})
+	assert.Contains(t, rootResources, "genesyscloud_auth_division")
+	assert.NotContains(t, rootResources, "genesyscloud_routing_queue")
+
+	modules := root["module"].(map[string]interface{})
+	routing := modules["routing"].(map[string]interface{})
+	assert.Equal(t, "./modules/routing", routing["source"])
+	assert.Equal(t, "${genesyscloud_auth_division.division_a.id}", routing["genesyscloud_auth_division_division_a_id"])
+	queue := loadModuleResource(t, exportDir, "routing", "genesyscloud_routing_queue", "queue_a")
+	assert.NotContains(t, queue, "depends_on")
+	architect := modules["architect"].(map[string]interface{})
+	assert.Equal(t, "${module.routing.genesyscloud_routing_queue_queue_a_id}", architect["genesyscloud_routing_queue_queue_a_id"])
+	assert.Equal(t, "${var.genesyscloud_flow_flow_a_site_id}", architect["genesyscloud_flow_flow_a_site_id"])
+
+	// Cross module depends_on entries become depends_on entries of the module call or on the module
+	assert.Equal(t, []interface{}{"module.routing"}, architect["depends_on"])
+	assert.NotContains(t, routing, "depends_on")
+	user := rootResources["genesyscloud_user"].(map[string]interface{})["user_a"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"module.routing"}, user["depends_on"])
+
+	// Routing module exposes the queue and takes the division as a variable
+	routingModule, err := loadJsonFileToMap(filepath.Join(exportDir, defaultModulesDirectory, "routing", defaultTfJSONFile))
+	assert.Nil(t, err)
+	assert.Equal(t, "${var.genesyscloud_auth_division_division_a_id}", queue["division_id"])
+	assert.Contains(t, routingModule["variable"], "genesyscloud_auth_division_division_a_id")
+	output := routingModule["output"].(map[string]interface{})["genesyscloud_routing_queue_queue_a_id"].(map[string]interface{})
+	assert.Equal(t, "${genesyscloud_routing_queue.queue_a.id}", output["value"])
+
+	// Architect module references the queue through a variable and moves the cross module depends_on to the module call
+	architectModule, err := loadJsonFileToMap(filepath.Join(exportDir, defaultModulesDirectory, "architect", defaultTfJSONFile))
+	assert.Nil(t, err)
+	flow := architectModule["resource"].(map[string]interface{})["genesyscloud_flow"].(map[string]interface{})["flow_a"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"${var.genesyscloud_routing_queue_queue_a_id}"}, flow["queue_ids"])
+	assert.Equal(t, "${var.genesyscloud_flow_flow_a_site_id}", flow["site_id"])
+	assert.NotContains(t, flow, "depends_on")
+	variables := architectModule["variable"].(map[string]interface{})
+	assert.Contains(t, variables, "genesyscloud_routing_queue_queue_a_id")
+	assert.Contains(t, variables, "genesyscloud_flow_flow_a_site_id")
+
+	_, err = os.Stat(filepath.Join(exportDir, defaultTfVarsFile))
+	assert.Nil(t, err)
+}
+
+func TestUnitTfExportModuleLayoutHCLDependsOn(t *testing.T) {
+	resourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_routing_queue": {
+			"queue_a": util.JsonMap{"name": "Queue A"},
+		},
+		"genesyscloud_flow": {
+			"flow_a": util.JsonMap{
+				"filepath":   "flows/flow-a.yaml",
+				"depends_on": []string{"$dep$genesyscloud_routing_queue.queue_a$dep$"},
+			},
+		},
+	}
+	resourceModules := map[string]string{
+		"genesyscloud_routing_queue.queue_a": "routing",
+		"genesyscloud_flow.flow_a":           "architect",
+	}
+
+	exportDir := t.TempDir()
+	m := NewModuleExporter(resourceTypesMaps, map[string]resourceJSONMaps{}, nil, resourceModules, "mypurecloud/genesyscloud", "0.1.0", exportDir, true)
+	assert.Nil(t, m.exportModules())
+
+	root, err := os.ReadFile(filepath.Join(exportDir, defaultTfHCLFile))
+	assert.Nil(t, err)
+	assert.Contains(t, string(root), "depends_on = [module.routing]")
+}
+
+func loadModuleResource(t *testing.T, exportDir string, module string, resType string, resName string) map[string]interface{} {
+	config, err := loadJsonFileToMap(filepath.Join(exportDir, defaultModulesDirectory, module, defaultTfJSONFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config["resource"].(map[string]interface{})[resType].(map[string]interface{})[resName].(map[string]interface{})
+}
+
+func TestUnitTfExportDomainModuleName(t *testing.T) {
+	assert.Equal(t, "routing", domainModuleName("genesyscloud_routing_queue"))
+	assert.Equal(t, "telephony", domainModuleName("genesyscloud_telephony_providers_edges_site"))
+	assert.Equal(t, "outbound", domainModuleName("genesyscloud_outbound_campaign"))
+	assert.Equal(t, "architect", domainModuleName("genesyscloud_flow"))
+	assert.Equal(t, "architect", domainModuleName("genesyscloud_architect_schedules"))
+	assert.Equal(t, "", domainModuleName("genesyscloud_user"))
+}
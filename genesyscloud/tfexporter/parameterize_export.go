@@ -0,0 +1,114 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+This file contains all of the logic used for parameterized exports. A parameterized export replaces environment specific values such as
+phone numbers, email domains and site names with variables. One '<env>.auto.tfvars' template is written per target environment holding
+the exported values and the values of the unresolvable attributes, so the same export can be promoted from one org to the next.
+*/
+
+const defaultEnvironmentsDirectory = "environments"
+
+var defaultParameterizeEnvironments = []string{"dev", "test", "prod"}
+
+func (g *GenesysCloudResourceExporter) setupParameterize() {
+	if !g.parameterize {
+		return
+	}
+	g.environments = defaultParameterizeEnvironments
+	if environments, ok := g.d.GetOk("parameterize_environments"); ok {
+		g.environments = lists.InterfaceListToStrings(environments.([]interface{}))
+	}
+}
+
+// parameterizeAttribute replaces an environment specific value in the config with a variable
+func (g *GenesysCloudResourceExporter) parameterizeAttribute(resourceType string, resourceName string, currAttr string, key string, configMap map[string]interface{}) {
+	value := configMap[key]
+	if !g.parameterize || value == nil || value == "" {
+		return
+	}
+
+	varType := schema.TypeString
+	switch value.(type) {
+	case bool:
+		varType = schema.TypeBool
+	case int, int32, int64, float32, float64:
+		varType = schema.TypeFloat
+	case string:
+	default:
+		log.Printf("Unable to parameterize attribute %s of %s.%s with value of type %T", currAttr, resourceType, resourceName, value)
+		return
+	}
+
+	// The same attribute can occur once for every item of a list so a suffix is added after the first occurrence
+	if g.parameterizedAttrs == nil {
+		g.parameterizedAttrs = make(map[string]int)
+	}
+	name := strings.ReplaceAll(currAttr, ".", "_")
+	occurrenceKey := fmt.Sprintf("%s.%s.%s", resourceType, resourceName, name)
+	if count := g.parameterizedAttrs[occurrenceKey]; count > 0 {
+		name = fmt.Sprintf("%s_%d", name, count)
+	}
+	g.parameterizedAttrs[occurrenceKey]++
+
+	attr := unresolvableAttributeInfo{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Name:         name,
+		Schema: &schema.Schema{
+			Type:        varType,
+			Description: fmt.Sprintf("Environment specific value of %s for %s.%s", currAttr, resourceType, resourceName),
+		},
+		Parameterized: true,
+		Value:         value,
+	}
+	g.unresolvedAttrs = append(g.unresolvedAttrs, attr)
+	configMap[key] = fmt.Sprintf("${var.%s}", createUnresolvedAttrKey(attr))
+}
+
+// writeEnvironmentTfVars writes a tfvars template for every target environment of a parameterized export
+func (g *GenesysCloudResourceExporter) writeEnvironmentTfVars() diag.Diagnostics {
+	if !g.parameterize {
+		return nil
+	}
+
+	tfVars := make(map[string]interface{})
+	for _, attr := range g.unresolvedAttrs {
+		key := createUnresolvedAttrKey(attr)
+		if attr.Parameterized {
+			tfVars[key] = attr.Value
+		} else {
+			tfVars[key] = determineVarValue(attr.Schema)
+		}
+	}
+
+	environmentsDir := filepath.Join(g.exportDirPath, defaultEnvironmentsDirectory)
+	if err := os.MkdirAll(environmentsDir, os.ModePerm); err != nil {
+		return diag.Errorf("Failed to create environments directory %s: %v", environmentsDir, err)
+	}
+
+	for _, environment := range g.environments {
+		tfVarsStr := fmt.Sprintf("// This file has been autogenerated as a template for the %s environment. The values have been taken from the exported org"+
+			"\n// and should be edited as necessary. Apply the config with '-var-file=%s/%s.auto.tfvars' or copy this file next to the config.\n\n%s",
+			environment, defaultEnvironmentsDirectory, environment, generateTfVarsContent(tfVars))
+
+		path := filepath.Join(environmentsDir, environment+".auto.tfvars")
+		log.Printf("Writing export environment tfvars file to %s", path)
+		if diagErr := files.WriteToFile([]byte(tfVarsStr), path); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
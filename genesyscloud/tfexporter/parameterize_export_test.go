@@ -0,0 +1,66 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportParameterize(t *testing.T) {
+	resType := "genesyscloud_unit_test_resource"
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		resType: {
+			EnvironmentSpecificAttributes: []string{"name", "addresses.number"},
+			CustomValidateExports: map[string][]string{
+				"E164": {"phone"},
+			},
+		},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		parameterize:  true,
+		environments:  []string{"dev", "prod"},
+		exportDirPath: t.TempDir(),
+	}
+
+	configMap := map[string]interface{}{
+		"name":        "Site A",
+		"phone":       "+13175550100",
+		"description": "Not environment specific",
+		"addresses": []interface{}{
+			map[string]interface{}{"number": "+13175550101"},
+			map[string]interface{}{"number": "+13175550102"},
+		},
+	}
+	g.sanitizeConfigMap(resType, "site_a", configMap, "", exporters, false, false, false)
+
+	assert.Equal(t, "${var.genesyscloud_unit_test_resource_site_a_name}", configMap["name"])
+	assert.Equal(t, "${var.genesyscloud_unit_test_resource_site_a_phone}", configMap["phone"])
+	assert.Equal(t, "Not environment specific", configMap["description"])
+	addresses := configMap["addresses"].([]interface{})
+	assert.Equal(t, "${var.genesyscloud_unit_test_resource_site_a_addresses_number}", addresses[0].(map[string]interface{})["number"])
+	assert.Equal(t, "${var.genesyscloud_unit_test_resource_site_a_addresses_number_1}", addresses[1].(map[string]interface{})["number"])
+
+	assert.Len(t, g.unresolvedAttrs, 4)
+	for _, attr := range g.unresolvedAttrs {
+		assert.True(t, attr.Parameterized)
+	}
+
+	diagErr := g.writeEnvironmentTfVars()
+	assert.Nil(t, diagErr)
+	for _, environment := range []string{"dev", "prod"} {
+		content, err := os.ReadFile(filepath.Join(g.exportDirPath, defaultEnvironmentsDirectory, environment+".auto.tfvars"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(content), `genesyscloud_unit_test_resource_site_a_name = "Site A"`)
+		assert.Contains(t, string(content), `genesyscloud_unit_test_resource_site_a_addresses_number_1 = "+13175550102"`)
+	}
+
+	// Parameterized values are not written to terraform.tfvars
+	diagErr = writeUnresolvedAttrsTfVars(g.unresolvedAttrs, g.exportDirPath)
+	assert.Nil(t, diagErr)
+	_, err := os.Stat(filepath.Join(g.exportDirPath, defaultTfVarsFile))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	gcloud "terraform-provider-genesyscloud/genesyscloud/validators"
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
@@ -13,11 +14,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 )
 
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterResource("genesyscloud_tf_export", ResourceTfExport())
+	l.RegisterDataSource("genesyscloud_org_snapshot", DataSourceOrgSnapshot())
 
 }
 
@@ -31,6 +34,7 @@ func ResourceTfExport() *schema.Resource {
 		CreateContext: createTfExport,
 		ReadContext:   readTfExport,
 		DeleteContext: deleteTfExport,
+		CustomizeDiff: customizeTfExportDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -66,7 +70,7 @@ func ResourceTfExport() *schema.Resource {
 				ConflictsWith: []string{"resource_types", "exclude_filter_resources"},
 			},
 			"replace_with_datasource": {
-				Description: "Include only resources that match either a resource type or a resource type::regular expression.  See export guide for additional information",
+				Description: "Replace the exported objects that match either a resource type or a resource type::regular expression with data sources that look the objects up by name. References to the objects are rewritten to the data sources. Patterns for resource types without a data source are ignored. See export guide for additional information",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem: &schema.Schema{
@@ -92,6 +96,13 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"include_import_blocks": {
+				Description: fmt.Sprintf("Export Terraform import blocks for every exported resource to '%s' or '%s'. This can be used with Terraform 1.5+ to begin managing existing resources with terraform without a state file.", defaultTfHCLImportsFile, defaultTfJSONImportsFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"export_as_hcl": {
 				Description: "Export the config as HCL.",
 				Type:        schema.TypeBool,
@@ -99,6 +110,36 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"export_format": {
+				Description:   fmt.Sprintf("Format of the exported config: `%s`, `%s` or `%s`. `%s` exports a CDK for Terraform application ('%s' and '%s' or '%s') in the language selected with `cdktf_language`. Run 'cdktf get' in the export directory to generate the typed constructs from the provider schema. Defaults to `%s` when `export_as_hcl` is set and `%s` otherwise.", exportFormatHCL, exportFormatJSON, exportFormatCDKTF, exportFormatCDKTF, defaultCdktfConfigFile, defaultCdktfTypeScriptFile, defaultCdktfGoFile, exportFormatHCL, exportFormatJSON),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{exportFormatHCL, exportFormatJSON, exportFormatCDKTF}, false),
+				ForceNew:      true,
+				ConflictsWith: []string{"export_as_hcl"},
+			},
+			"cdktf_language": {
+				Description:  fmt.Sprintf("Language of the CDK for Terraform application exported with the `%s` export format: `%s` or `%s`.", exportFormatCDKTF, cdktfLanguageTypeScript, cdktfLanguageGo),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cdktfLanguageTypeScript,
+				ValidateFunc: validation.StringInSlice([]string{cdktfLanguageTypeScript, cdktfLanguageGo}, false),
+				ForceNew:     true,
+			},
+			"secret_reference_style": {
+				Description:  fmt.Sprintf("Replace sensitive attributes, such as integration credential fields and identity provider certificates, with references to a data source of an external secret store instead of variables: `%s` (`%s`) or `%s` (`%s`). Every attribute is read from its own secret at the path built from `secret_path_template`.", secretReferenceStyleVault, vaultSecretDataSourceType, secretReferenceStyleAWSSecretsManager, awsSecretsManagerSecretDataSourceType),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{secretReferenceStyleVault, secretReferenceStyleAWSSecretsManager}, false),
+				ForceNew:     true,
+			},
+			"secret_path_template": {
+				Description:  fmt.Sprintf("Template of the path of the secret holding a sensitive attribute when `secret_reference_style` is set. `%s`, `%s` and `%s` are replaced with the resource type, the resource name and the attribute name. Defaults to `%s` for `%s` and `%s` for `%s`.", secretPathTemplateResourceType, secretPathTemplateResourceName, secretPathTemplateAttribute, defaultVaultSecretPathTemplate, secretReferenceStyleVault, defaultAWSSecretsManagerPathTemplate, secretReferenceStyleAWSSecretsManager),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"secret_reference_style"},
+			},
 			"split_files_by_resource": {
 				Description: "Split export files by resource type. This will also split the terraform provider and variable declarations into their own files.",
 				Type:        schema.TypeBool,
@@ -106,8 +147,16 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"module_layout": {
+				Description:   fmt.Sprintf("Export the resources into one child module per division (`%s`) or per domain (`%s`) under the '%s' directory. The domain modules are routing, telephony, outbound and architect. Resources that do not belong to a module are kept in the root module and references between modules are wired through generated output and variable blocks.", moduleLayoutDivision, moduleLayoutDomain, defaultModulesDirectory),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{moduleLayoutDivision, moduleLayoutDomain}, false),
+				ForceNew:      true,
+				ConflictsWith: []string{"split_files_by_resource"},
+			},
 			"log_permission_errors": {
-				Description: "Log permission/product issues rather than fail.",
+				Description: fmt.Sprintf("Log permission/product issues rather than fail. The skipped resource types are listed in '%s'.", defaultExportReportFile),
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
@@ -120,13 +169,54 @@ func ResourceTfExport() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				ForceNew:    true,
 			},
+			"transformation_file": {
+				Description: fmt.Sprintf("Path to a JSON file with rules that transform the exported config. The rules are keyed by resource type and attribute path and %s, %s, %s or %s the value of the attribute in every exported resource of the type before the config is written. See export guide for additional information", transformationActionRename, transformationActionSet, transformationActionDrop, transformationActionTemplatize),
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
 			"enable_dependency_resolution": {
-				Description: "Adds a \"depends_on\" attribute to genesyscloud_flow resources with a list of resources that are referenced inside the flow configuration . This also resolves and exports all the dependent resources for any given resource.",
+				Description: fmt.Sprintf("Adds a \"depends_on\" attribute to genesyscloud_flow resources with a list of resources that are referenced inside the flow configuration . This also resolves and exports all the dependent resources for any given resource. The dependency graph of the exported resources is written to '%s' and '%s'.", defaultDependenciesDOTFile, defaultDependenciesJSONFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"enable_transitive_dependency_resolution": {
+				Description: "Walk the reference attributes of every exported resource and add the referenced objects to the export, repeating for the added objects until every reference resolves to an exported resource. Referenced objects are exported even when they do not match the include or exclude filters.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"incremental_export": {
+				Description: fmt.Sprintf("Only read objects that are new or have changed since the previous incremental export into the same directory. A manifest holding the version and full state of the exported objects is kept in '%s'. It can only be read by the user running Terraform and is not removed when the export is destroyed. Only the flows, scripts, skills, wrap-up codes, schedules, schedule groups, IVRs and emergency groups report a version when listed. Objects of every other type, including divisions, users and queues, are always read. The output files are rewritten in full by every export.", defaultExportManifestFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"resume": {
+				Description: fmt.Sprintf("Resume a failed export into the same directory. The objects read by an export, including their full state, are recorded in '%s' until the export completes. The file can only be read by the user running Terraform. When true, the objects recorded by a failed export are not read again and the resource types it completed are not listed again. When false, any previous checkpoint is discarded.", defaultExportCheckpointFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"parameterize": {
+				Description: fmt.Sprintf("Replace environment specific values with variables: E.164 phone numbers, user phone numbers, DID pool ranges, routing email domains and site names. A tfvars template is written to '%s/<env>.auto.tfvars' for every environment in `parameterize_environments` with the exported values. Unresolvable attributes such as integration credentials and trunk edge IDs are not read from the org and have empty values in every template.", defaultEnvironmentsDirectory),
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				ForceNew:    true,
 			},
+			"parameterize_environments": {
+				Description: fmt.Sprintf("Environments to write a tfvars template for when `parameterize` is `true`. Defaults to `[\"%s\"]`.", strings.Join(defaultParameterizeEnvironments, "\", \"")),
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+			},
 			"ignore_cyclic_deps": {
 				Description: "Ignore Cyclic Dependencies when building the flows and do not throw an error",
 				Type:        schema.TypeBool,
@@ -142,8 +232,11 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	tfexporter_state.ActivateExporterState()
 
 	if _, ok := d.GetOk("include_filter_resources"); ok {
-		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, IncludeResources)
-		diagErr := gre.Export()
+		gre, diagErr := NewGenesysCloudResourceExporter(ctx, d, meta, IncludeResources)
+		if diagErr != nil {
+			return diagErr
+		}
+		diagErr = gre.Export()
 		if diagErr != nil {
 			return diagErr
 		}
@@ -153,8 +246,11 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	if _, ok := d.GetOk("exclude_filter_resources"); ok {
-		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, ExcludeResources)
-		diagErr := gre.Export()
+		gre, diagErr := NewGenesysCloudResourceExporter(ctx, d, meta, ExcludeResources)
+		if diagErr != nil {
+			return diagErr
+		}
+		diagErr = gre.Export()
 		if diagErr != nil {
 			return diagErr
 		}
@@ -164,8 +260,11 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	//Dealing with the traditional resource
-	gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, LegacyInclude)
-	diagErr := gre.Export()
+	gre, diagErr := NewGenesysCloudResourceExporter(ctx, d, meta, LegacyInclude)
+	if diagErr != nil {
+		return diagErr
+	}
+	diagErr = gre.Export()
 
 	if diagErr != nil {
 		return diagErr
@@ -176,6 +275,17 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// customizeTfExportDiff rejects the export settings that are not supported together at plan time
+func customizeTfExportDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("export_format").(string) == exportFormatCDKTF && diff.Get("module_layout").(string) != "" {
+		return fmt.Errorf("module_layout is not supported with the %s export format", exportFormatCDKTF)
+	}
+	if diff.Get("export_format").(string) == exportFormatCDKTF && diff.Get("secret_reference_style").(string) != "" {
+		return fmt.Errorf("secret_reference_style is not supported with the %s export format", exportFormatCDKTF)
+	}
+	return nil
+}
+
 // If the output directory doesn't exist or empty, mark the resource for creation.
 func readTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	path := d.Id()
@@ -192,15 +302,24 @@ func readTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag
 }
 
 // Delete everything (files and subdirectories) inside the export directory
-// not including the directory itself
+// not including the directory itself. The manifest of an incremental export is kept
+// so the next export only needs to read changed objects, and the lock is kept so the
+// next export uses the same resource addresses.
 func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	exportPath := d.Id()
+	keepManifest := d.Get("incremental_export").(bool)
 	dir, err := os.ReadDir(exportPath)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	for _, d := range dir {
-		os.RemoveAll(filepath.Join(exportPath, d.Name()))
+	for _, entry := range dir {
+		if keepManifest && entry.Name() == defaultExportManifestFile {
+			continue
+		}
+		if entry.Name() == defaultExportLockFile {
+			continue
+		}
+		os.RemoveAll(filepath.Join(exportPath, entry.Name()))
 	}
 
 	return nil
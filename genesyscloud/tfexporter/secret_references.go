@@ -0,0 +1,116 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+This file contains all of the functions used to export secrets as references to an external secret store. When a secret_reference_style
+is set, every sensitive attribute of an exported resource is replaced with a reference to a data source of the secret store instead of
+a variable. The data source reads one secret per attribute from a path built from the secret path template, so the exported config
+can be applied as soon as the secrets have been stored.
+*/
+
+const (
+	secretReferenceStyleVault             = "vault"
+	secretReferenceStyleAWSSecretsManager = "aws_secrets_manager"
+	secretPathTemplateResourceType        = "{resource_type}"
+	secretPathTemplateResourceName        = "{resource_name}"
+	secretPathTemplateAttribute           = "{attribute}"
+	defaultVaultSecretPathTemplate        = "secret/genesyscloud/{resource_type}/{resource_name}/{attribute}"
+	defaultAWSSecretsManagerPathTemplate  = "genesyscloud/{resource_type}/{resource_name}/{attribute}"
+	vaultSecretDataSourceType             = "vault_generic_secret"
+	awsSecretsManagerSecretDataSourceType = "aws_secretsmanager_secret_version"
+	vaultSecretValueKey                   = "value"
+)
+
+func (g *GenesysCloudResourceExporter) setupSecretReferences() diag.Diagnostics {
+	if g.secretReferenceStyle == "" {
+		return nil
+	}
+	if g.exportFormat == exportFormatCDKTF {
+		return diag.Errorf("secret_reference_style is not supported with the %s export format", exportFormatCDKTF)
+	}
+
+	if g.secretPathTemplate == "" {
+		g.secretPathTemplate = defaultVaultSecretPathTemplate
+		if g.secretReferenceStyle == secretReferenceStyleAWSSecretsManager {
+			g.secretPathTemplate = defaultAWSSecretsManagerPathTemplate
+		}
+	}
+	return nil
+}
+
+// secretPath builds the path of the secret holding an attribute of a resource from the path template
+func secretPath(template string, resourceType string, resourceName string, attribute string) string {
+	return strings.NewReplacer(
+		secretPathTemplateResourceType, resourceType,
+		secretPathTemplateResourceName, resourceName,
+		secretPathTemplateAttribute, attribute,
+	).Replace(template)
+}
+
+// secretDataSource returns the type and config of the data source that reads a secret and the expression that references the secret
+// value. Vault secrets hold the value under the 'value' key unless the attribute is a map, in which case every entry of the map is a
+// key of the secret. AWS Secrets Manager secrets hold lists and maps as JSON.
+func secretDataSource(style string, path string, dataSourceName string, valueType schema.ValueType) (string, util.JsonMap, string) {
+	if style == secretReferenceStyleAWSSecretsManager {
+		reference := fmt.Sprintf("data.%s.%s.secret_string", awsSecretsManagerSecretDataSourceType, dataSourceName)
+		if valueType == schema.TypeMap || valueType == schema.TypeList || valueType == schema.TypeSet {
+			reference = fmt.Sprintf("jsondecode(%s)", reference)
+		}
+		return awsSecretsManagerSecretDataSourceType, util.JsonMap{"secret_id": path}, fmt.Sprintf("${%s}", reference)
+	}
+
+	reference := fmt.Sprintf("data.%s.%s.data", vaultSecretDataSourceType, dataSourceName)
+	switch valueType {
+	case schema.TypeMap:
+	case schema.TypeList, schema.TypeSet:
+		reference = fmt.Sprintf("jsondecode(%s.%s)", reference, vaultSecretValueKey)
+	default:
+		reference = reference + "." + vaultSecretValueKey
+	}
+	return vaultSecretDataSourceType, util.JsonMap{"path": path}, fmt.Sprintf("${%s}", reference)
+}
+
+// referenceSecret replaces a sensitive attribute with a reference to the secret store and adds the data source that reads the secret
+func (g *GenesysCloudResourceExporter) referenceSecret(exporter *resourceExporter.ResourceExporter, resourceType string, resourceName string, currAttr string, key string, configMap map[string]interface{}) {
+	attribute := strings.ReplaceAll(currAttr, ".", "_")
+	dataSourceName := fmt.Sprintf("%s_%s_%s", resourceType, resourceName, attribute)
+	path := secretPath(g.secretPathTemplate, resourceType, resourceName, attribute)
+
+	dataSourceType, dataSourceConfig, reference := secretDataSource(g.secretReferenceStyle, path, dataSourceName, g.secretValueType(exporter, resourceType, currAttr, configMap[key]))
+	log.Printf("Reading %s of %s.%s from secret %s", currAttr, resourceType, resourceName, path)
+	configMap[key] = reference
+	g.addDataSource(dataSourceType, dataSourceName, dataSourceConfig)
+}
+
+// secretValueType returns the type of a sensitive attribute from the schema of the attribute or, if there is none, from its value
+func (g *GenesysCloudResourceExporter) secretValueType(exporter *resourceExporter.ResourceExporter, resourceType string, currAttr string, value interface{}) schema.ValueType {
+	if attr, ok := exporter.UnResolvableAttributes[currAttr]; ok {
+		return attr.Type
+	}
+	if g.provider != nil {
+		if resource, ok := g.provider.ResourcesMap[resourceType]; ok {
+			if attr, ok := resource.SchemaMap()[currAttr]; ok {
+				return attr.Type
+			}
+		}
+	}
+
+	switch value.(type) {
+	case map[string]interface{}:
+		return schema.TypeMap
+	case []interface{}:
+		return schema.TypeList
+	}
+	return schema.TypeString
+}
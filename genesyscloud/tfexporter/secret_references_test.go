@@ -0,0 +1,67 @@
+package tfexporter
+
+import (
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportSecretReferences(t *testing.T) {
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_integration_credential": {
+			UnResolvableAttributes: map[string]*schema.Schema{
+				"fields": {Type: schema.TypeMap, Sensitive: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		},
+		"genesyscloud_idp_okta": {
+			SecretAttributes: []string{"certificates"},
+		},
+		"genesyscloud_telephony_providers_edges_trunk": {
+			UnResolvableAttributes: map[string]*schema.Schema{
+				"edge_id": {Type: schema.TypeString},
+			},
+		},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		secretReferenceStyle: secretReferenceStyleVault,
+		dataSourceTypesMaps:  make(map[string]resourceJSONMaps),
+	}
+	assert.Nil(t, g.setupSecretReferences())
+	assert.Equal(t, defaultVaultSecretPathTemplate, g.secretPathTemplate)
+
+	// Sensitive unresolvable attributes are read from the secret store instead of a variable
+	credential := map[string]interface{}{"name": "Zendesk", "fields": nil}
+	unresolved, _ := g.sanitizeConfigMap("genesyscloud_integration_credential", "Zendesk", credential, "", exporters, false, false, false)
+	assert.Empty(t, unresolved)
+	assert.Equal(t, "${data.vault_generic_secret.genesyscloud_integration_credential_Zendesk_fields.data}", credential["fields"])
+	assert.Equal(t, util.JsonMap{"path": "secret/genesyscloud/genesyscloud_integration_credential/Zendesk/fields"}, g.dataSourceTypesMaps[vaultSecretDataSourceType]["genesyscloud_integration_credential_Zendesk_fields"])
+
+	idp := map[string]interface{}{"issuer_uri": "https://okta.example.com", "certificates": []interface{}{"MIIC..."}}
+	g.sanitizeConfigMap("genesyscloud_idp_okta", "okta", idp, "", exporters, false, false, false)
+	assert.Equal(t, "${jsondecode(data.vault_generic_secret.genesyscloud_idp_okta_okta_certificates.data.value)}", idp["certificates"])
+	assert.Equal(t, "https://okta.example.com", idp["issuer_uri"])
+
+	// Attributes that are not sensitive are still exported as variables
+	trunk := map[string]interface{}{"edge_id": "edge-1"}
+	unresolved, _ = g.sanitizeConfigMap("genesyscloud_telephony_providers_edges_trunk", "trunk", trunk, "", exporters, false, false, false)
+	assert.Len(t, unresolved, 1)
+	assert.Equal(t, "${var.genesyscloud_telephony_providers_edges_trunk_trunk_edge_id}", trunk["edge_id"])
+
+	// AWS Secrets Manager secrets hold lists and maps as JSON
+	dataSourceType, config, reference := secretDataSource(secretReferenceStyleAWSSecretsManager, "genesyscloud/okta/certificates", "okta_certificates", schema.TypeList)
+	assert.Equal(t, awsSecretsManagerSecretDataSourceType, dataSourceType)
+	assert.Equal(t, util.JsonMap{"secret_id": "genesyscloud/okta/certificates"}, config)
+	assert.Equal(t, "${jsondecode(data.aws_secretsmanager_secret_version.okta_certificates.secret_string)}", reference)
+
+	_, _, reference = secretDataSource(secretReferenceStyleAWSSecretsManager, "genesyscloud/user/password", "user_password", schema.TypeString)
+	assert.Equal(t, "${data.aws_secretsmanager_secret_version.user_password.secret_string}", reference)
+
+	assert.Equal(t, "kv/prod/genesyscloud_idp_okta/fields", secretPath("kv/prod/{resource_type}/{attribute}", "genesyscloud_idp_okta", "okta", "fields"))
+
+	g = &GenesysCloudResourceExporter{secretReferenceStyle: secretReferenceStyleVault, exportFormat: exportFormatCDKTF}
+	assert.NotNil(t, g.setupSecretReferences())
+}
@@ -0,0 +1,99 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"log"
+	"path/filepath"
+	"sort"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains all of the code used to generate Terraform import blocks for the exported resources. Import blocks are supported by
+Terraform 1.5+ and allow existing objects to be brought under management by running 'terraform apply' against the exported config,
+without the need for a state file.
+*/
+type TFImportBlockWriter struct {
+	resources   []resourceExporter.ResourceInfo
+	dirPath     string
+	exportAsHCL bool
+
+	// Resource address -> name of the child module the resource is exported to
+	resourceModules map[string]string
+}
+
+type importBlock struct {
+	To string `json:"to"`
+	Id string `json:"id"`
+}
+
+func NewTFImportBlockWriter(resources []resourceExporter.ResourceInfo, dirPath string, exportAsHCL bool, resourceModules map[string]string) *TFImportBlockWriter {
+	return &TFImportBlockWriter{
+		resources:       resources,
+		dirPath:         dirPath,
+		exportAsHCL:     exportAsHCL,
+		resourceModules: resourceModules,
+	}
+}
+
+func (t *TFImportBlockWriter) writeImportBlocks() diag.Diagnostics {
+	blocks := t.buildImportBlocks()
+
+	var (
+		data []byte
+		path string
+	)
+	if t.exportAsHCL {
+		data = createHCLImportBlocks(blocks)
+		path = filepath.Join(t.dirPath, defaultTfHCLImportsFile)
+	} else {
+		var err error
+		data, err = json.MarshalIndent(util.JsonMap{"import": blocks}, "", "  ")
+		if err != nil {
+			return diag.Errorf("Failed to encode import blocks as JSON: %v", err)
+		}
+		path = filepath.Join(t.dirPath, defaultTfJSONImportsFile)
+	}
+
+	log.Printf("Writing export import blocks file to %s", path)
+	return files.WriteToFile(data, path)
+}
+
+// buildImportBlocks returns an import block for every exported resource sorted by resource address
+func (t *TFImportBlockWriter) buildImportBlocks() []importBlock {
+	blocks := make([]importBlock, 0, len(t.resources))
+	for _, resource := range t.resources {
+		if resource.State == nil || resource.State.ID == "" {
+			continue
+		}
+		blocks = append(blocks, importBlock{
+			To: resourceAddress(resource.Type, resource.Name, t.resourceModules),
+			Id: resource.State.ID,
+		})
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].To < blocks[j].To
+	})
+	return blocks
+}
+
+func createHCLImportBlocks(blocks []importBlock) []byte {
+	f := hclwrite.NewEmptyFile()
+	rootBody := f.Body()
+	for i, block := range blocks {
+		if i > 0 {
+			rootBody.AppendNewline()
+		}
+		importBody := rootBody.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", addressTraversal(block.To))
+		importBody.SetAttributeValue("id", zclconfCty.StringVal(block.Id))
+	}
+	return f.Bytes()
+}
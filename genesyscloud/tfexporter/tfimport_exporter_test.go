@@ -0,0 +1,47 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportImportBlocks(t *testing.T) {
+	resources := []resourceExporter.ResourceInfo{
+		{Type: "genesyscloud_user", Name: "user_b", State: &terraform.InstanceState{ID: "user-b-id"}},
+		{Type: "genesyscloud_routing_queue", Name: "queue_a", State: &terraform.InstanceState{ID: "queue-a-id"}},
+	}
+
+	hclDir := t.TempDir()
+	diagErr := NewTFImportBlockWriter(resources, hclDir, true, nil).writeImportBlocks()
+	assert.Nil(t, diagErr)
+
+	hclContent, err := os.ReadFile(filepath.Join(hclDir, defaultTfHCLImportsFile))
+	assert.Nil(t, err)
+	expectedHCL := `import {
+  to = genesyscloud_routing_queue.queue_a
+  id = "queue-a-id"
+}
+
+import {
+  to = genesyscloud_user.user_b
+  id = "user-b-id"
+}
+`
+	assert.Equal(t, expectedHCL, string(hclContent))
+
+	jsonDir := t.TempDir()
+	diagErr = NewTFImportBlockWriter(resources, jsonDir, false, nil).writeImportBlocks()
+	assert.Nil(t, diagErr)
+
+	jsonContent, err := loadJsonFileToMap(filepath.Join(jsonDir, defaultTfJSONImportsFile))
+	assert.Nil(t, err)
+	blocks := jsonContent["import"].([]interface{})
+	assert.Len(t, blocks, 2)
+	assert.Equal(t, "genesyscloud_routing_queue.queue_a", blocks[0].(map[string]interface{})["to"])
+	assert.Equal(t, "queue-a-id", blocks[0].(map[string]interface{})["id"])
+}
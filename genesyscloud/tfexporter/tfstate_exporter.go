@@ -1,39 +1,71 @@
 package tfexporter
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
-	"os/exec"
-	"path/filepath"
+	"sort"
 	"strings"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util/files"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 /*
 This files contains all of the code used to create an export's Terraform state file.  The TFStateFileWriter struct encapsulates all the logic to write a Terraform state file.
+The state is written directly in the Terraform state format version 4 used by Terraform 0.13+, so the Terraform CLI is not needed to upgrade it.
 The other functions in this file deal with how to generate the TFVars we create during the export.
 */
+const (
+	tfStateFormatVersion    = 4
+	tfStateTerraformVersion = "0.13.0"
+)
+
 type TFStateFileWriter struct {
-	ctx            context.Context
-	resources      []resourceExporter.ResourceInfo
-	d              *schema.ResourceData
-	providerSource string
+	resources         []resourceExporter.ResourceInfo
+	d                 *schema.ResourceData
+	providerSource    string
+	providerResources map[string]*schema.Resource
+
+	// Resource address -> name of the child module the resource is exported to
+	resourceModules map[string]string
+}
+
+// tfStateV4 is the JSON representation of a Terraform state file in format version 4
+type tfStateV4 struct {
+	Version          int                    `json:"version"`
+	TerraformVersion string                 `json:"terraform_version"`
+	Serial           uint64                 `json:"serial"`
+	Lineage          string                 `json:"lineage"`
+	Outputs          map[string]interface{} `json:"outputs"`
+	Resources        []tfStateV4Resource    `json:"resources"`
+}
+
+type tfStateV4Resource struct {
+	Module    string                      `json:"module,omitempty"`
+	Mode      string                      `json:"mode"`
+	Type      string                      `json:"type"`
+	Name      string                      `json:"name"`
+	Provider  string                      `json:"provider"`
+	Instances []tfStateV4ResourceInstance `json:"instances"`
+}
+
+type tfStateV4ResourceInstance struct {
+	SchemaVersion       int                    `json:"schema_version"`
+	Attributes          map[string]interface{} `json:"attributes"`
+	SensitiveAttributes []interface{}          `json:"sensitive_attributes"`
 }
 
-func NewTFStateWriter(ctx context.Context, resources []resourceExporter.ResourceInfo, d *schema.ResourceData, providerSource string) *TFStateFileWriter {
+func NewTFStateWriter(resources []resourceExporter.ResourceInfo, d *schema.ResourceData, providerSource string, providerResources map[string]*schema.Resource, resourceModules map[string]string) *TFStateFileWriter {
 	tfwriter := &TFStateFileWriter{
-		ctx:            ctx,
-		resources:      resources,
-		d:              d,
-		providerSource: providerSource,
+		resources:         resources,
+		d:                 d,
+		providerSource:    providerSource,
+		providerResources: providerResources,
+		resourceModules:   resourceModules,
 	}
 
 	return tfwriter
@@ -45,14 +77,9 @@ func (t *TFStateFileWriter) writeTfState() diag.Diagnostics {
 		return diagErr
 	}
 
-	tfstate := terraform.NewState()
-	for _, resource := range t.resources {
-		resourceState := &terraform.ResourceState{
-			Type:     resource.Type,
-			Primary:  resource.State,
-			Provider: "provider.genesyscloud",
-		}
-		tfstate.RootModule().Resources[resource.Type+"."+resource.Name] = resourceState
+	tfstate, diagErr := t.buildTfState()
+	if diagErr != nil {
+		return diagErr
 	}
 
 	data, err := json.MarshalIndent(tfstate, "", "  ")
@@ -61,58 +88,68 @@ func (t *TFStateFileWriter) writeTfState() diag.Diagnostics {
 	}
 
 	log.Printf("Writing export state file to %s", stateFilePath)
-	if err := files.WriteToFile(data, stateFilePath); err != nil {
-		return err
-	}
-
-	// This outputs terraform state v3, and there is currently no public lib to generate v4 which is required for terraform 0.13+.
-	// However, the state can be upgraded automatically by calling the terraform CLI. If this fails, just print a warning indicating
-	// that the state likely needs to be upgraded manually.
-	cliError := `Failed to run the terraform CLI to upgrade the generated state file. 
-	The generated tfstate file will need to be upgraded manually by running the 
-	following in the state file's directory:
-	'terraform state replace-provider registry.terraform.io/-/genesyscloud registry.terraform.io/mypurecloud/genesyscloud'`
+	return files.WriteToFile(data, stateFilePath)
+}
 
-	tfpath, err := exec.LookPath("terraform")
-	if err != nil {
-		log.Println("Failed to find terraform path:", err)
-		log.Println(cliError)
-		return nil
+// buildTfState converts the exported resources into a version 4 Terraform state
+func (t *TFStateFileWriter) buildTfState() (*tfStateV4, diag.Diagnostics) {
+	tfstate := &tfStateV4{
+		Version:          tfStateFormatVersion,
+		TerraformVersion: tfStateTerraformVersion,
+		Serial:           1,
+		Lineage:          uuid.NewString(),
+		Outputs:          make(map[string]interface{}),
+		Resources:        make([]tfStateV4Resource, 0, len(t.resources)),
 	}
+	providerAddress := fmt.Sprintf(`provider["%s"]`, t.providerSource)
 
-	// exec.CommandContext does not auto-resolve symlinks
-	fileInfo, err := os.Lstat(tfpath)
-	if err != nil {
-		log.Println("Failed to Lstat terraform path:", err)
-		log.Println(cliError)
-		return nil
-	}
-	if fileInfo.Mode()&os.ModeSymlink != 0 {
-		tfpath, err = filepath.EvalSymlinks(tfpath)
+	for _, resource := range t.resources {
+		stateVal, err := schema.StateValueFromInstanceState(resource.State, resource.CtyType)
 		if err != nil {
-			log.Println("Failed to resolve terraform path symlink:", err)
-			log.Println(cliError)
-			return nil
+			return nil, diag.Errorf("Failed to read state of %s.%s: %v", resource.Type, resource.Name, err)
+		}
+		attributes, err := schema.StateValueToJSONMap(stateVal, resource.CtyType)
+		if err != nil {
+			return nil, diag.Errorf("Failed to encode state of %s.%s: %v", resource.Type, resource.Name, err)
+		}
+
+		schemaVersion := 0
+		if res, ok := t.providerResources[resource.Type]; ok && res != nil {
+			schemaVersion = res.SchemaVersion
+		}
+
+		moduleAddress := ""
+		if module := t.resourceModules[resource.Type+"."+resource.Name]; module != "" {
+			moduleAddress = "module." + module
 		}
-	}
 
-	cmd := exec.CommandContext(t.ctx, tfpath)
-	cmd.Args = append(cmd.Args, []string{
-		"state",
-		"replace-provider",
-		"-auto-approve",
-		"-state=" + stateFilePath,
-		"registry.terraform.io/-/genesyscloud",
-		t.providerSource,
-	}...)
-
-	log.Printf("Running 'terraform state replace-provider' on %s", stateFilePath)
-	if err = cmd.Run(); err != nil {
-		log.Println("Failed to run command:", err)
-		log.Println(cliError)
-		return nil
+		tfstate.Resources = append(tfstate.Resources, tfStateV4Resource{
+			Module:   moduleAddress,
+			Mode:     "managed",
+			Type:     resource.Type,
+			Name:     resource.Name,
+			Provider: providerAddress,
+			Instances: []tfStateV4ResourceInstance{
+				{
+					SchemaVersion:       schemaVersion,
+					Attributes:          attributes,
+					SensitiveAttributes: make([]interface{}, 0),
+				},
+			},
+		})
 	}
-	return nil
+
+	sort.Slice(tfstate.Resources, func(i, j int) bool {
+		if tfstate.Resources[i].Module != tfstate.Resources[j].Module {
+			return tfstate.Resources[i].Module < tfstate.Resources[j].Module
+		}
+		if tfstate.Resources[i].Type != tfstate.Resources[j].Type {
+			return tfstate.Resources[i].Type < tfstate.Resources[j].Type
+		}
+		return tfstate.Resources[i].Name < tfstate.Resources[j].Name
+	})
+
+	return tfstate, nil
 }
 
 func generateTfVarsContent(vars map[string]interface{}) string {
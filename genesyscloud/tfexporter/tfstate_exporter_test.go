@@ -0,0 +1,60 @@
+package tfexporter
+
+import (
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportBuildTfStateV4(t *testing.T) {
+	resourceType := "genesyscloud_unit_test_resource"
+	testResource := &schema.Resource{
+		SchemaVersion: 2,
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+			"tags": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+	ctyType := testResource.CoreConfigSchema().ImpliedType()
+
+	resources := []resourceExporter.ResourceInfo{
+		{
+			Type:    resourceType,
+			Name:    "resource_b",
+			CtyType: ctyType,
+			State:   &terraform.InstanceState{ID: "id-b", Attributes: map[string]string{"id": "id-b", "name": "b", "tags.#": "1", "tags.0": "tag"}},
+		},
+		{
+			Type:    resourceType,
+			Name:    "resource_a",
+			CtyType: ctyType,
+			State:   &terraform.InstanceState{ID: "id-a", Attributes: map[string]string{"id": "id-a", "name": "a"}},
+		},
+	}
+
+	providerSource := "registry.terraform.io/mypurecloud/genesyscloud"
+	writer := NewTFStateWriter(resources, nil, providerSource, map[string]*schema.Resource{resourceType: testResource}, nil)
+	tfstate, diagErr := writer.buildTfState()
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, 4, tfstate.Version)
+	assert.Equal(t, uint64(1), tfstate.Serial)
+	assert.NotEmpty(t, tfstate.Lineage)
+	assert.Len(t, tfstate.Resources, 2)
+
+	first := tfstate.Resources[0]
+	assert.Equal(t, "managed", first.Mode)
+	assert.Equal(t, resourceType, first.Type)
+	assert.Equal(t, "resource_a", first.Name)
+	assert.Equal(t, `provider["registry.terraform.io/mypurecloud/genesyscloud"]`, first.Provider)
+	assert.Len(t, first.Instances, 1)
+	assert.Equal(t, 2, first.Instances[0].SchemaVersion)
+	assert.Equal(t, "id-a", first.Instances[0].Attributes["id"])
+	assert.Equal(t, "a", first.Instances[0].Attributes["name"])
+
+	second := tfstate.Resources[1]
+	assert.Equal(t, []interface{}{"tag"}, second.Instances[0].Attributes["tags"])
+}
@@ -0,0 +1,129 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mohae/deepcopy"
+)
+
+/*
+This file contains the logic used to close the export over the references of the exported objects. Every reference attribute
+(RefAttrs and EncodedRefAttrs) of the exported objects is walked and the referenced objects that are not already part of the export
+are read and added to it. This is repeated for the added objects until every reference points to an exported object, so exporting a
+single queue also exports its wrap-up codes, skills, scripts and flows along with everything those reference.
+*/
+
+// exportTransitiveDependencies adds the objects referenced by the exported objects to the export until the export is closed
+func (g *GenesysCloudResourceExporter) exportTransitiveDependencies() diag.Diagnostics {
+	if !g.transitiveDependencies {
+		return nil
+	}
+
+	// Objects that have been looked up, keyed by <type>::<id>. Referenced objects that no longer exist are only looked up once.
+	attempted := make(map[string]bool)
+	for round := 1; ; round++ {
+		missing := g.unexportedReferences(attempted)
+		if len(missing) == 0 {
+			return nil
+		}
+
+		filter := make([]string, 0)
+		exporters := make(map[string]*resourceExporter.ResourceExporter)
+		registeredExporters := resourceExporter.GetResourceExporters()
+		for refType, ids := range missing {
+			for _, id := range ids {
+				attempted[refType+"::"+id] = true
+				filter = append(filter, fmt.Sprintf("%s::%s", refType, id))
+			}
+
+			registered, ok := registeredExporters[refType]
+			if !ok {
+				log.Printf("No exporter registered for referenced resource type %s. Skipping %d referenced objects.", refType, len(ids))
+				continue
+			}
+			// The registered exporters are shared, so the referenced objects are listed into a copy
+			exporter, _ := deepcopy.Copy(registered).(*resourceExporter.ResourceExporter)
+			exporters[refType] = exporter
+		}
+		log.Printf("Transitive dependency resolution round %d: reading %d referenced objects", round, len(filter))
+
+		resourceFilter := g.resourceFilter
+		g.resourceFilter = FilterResourceById
+		diagErr := g.buildSanitizedResourceMaps(exporters, filter, g.logPermissionErrors)
+		g.resourceFilter = resourceFilter
+		if diagErr != nil {
+			return diagErr
+		}
+
+		for _, resType := range sortedKeys(exporters) {
+			exporter := exporters[resType]
+			if len(exporter.SanitizedResourceMap) == 0 {
+				continue
+			}
+			resources, diagErr := g.getResourcesForType(resType, g.provider, exporter, g.meta)
+			if diagErr != nil {
+				return diagErr
+			}
+			g.resources = append(g.resources, resources...)
+		}
+		g.mergeReferencedExporters(exporters)
+	}
+}
+
+// unexportedReferences returns the IDs of the objects, grouped by resource type, that are referenced by exported objects but are not
+// exported themselves and have not been looked up yet
+func (g *GenesysCloudResourceExporter) unexportedReferences(attempted map[string]bool) map[string][]string {
+	exported := make(map[string]bool)
+	for _, resource := range g.resources {
+		if resource.State != nil {
+			exported[resource.Type+"::"+resource.State.ID] = true
+		}
+	}
+
+	var exporters map[string]*resourceExporter.ResourceExporter
+	if g.exporters != nil {
+		exporters = *g.exporters
+	}
+
+	missing := make(map[string][]string)
+	for _, resource := range g.resources {
+		for _, reference := range resourceReferences(exporters[resource.Type], resource.State) {
+			key := reference.RefType + "::" + reference.RefId
+			if exported[key] || attempted[key] {
+				continue
+			}
+			exported[key] = true
+			missing[reference.RefType] = append(missing[reference.RefType], reference.RefId)
+		}
+	}
+
+	for _, ids := range missing {
+		sort.Strings(ids)
+	}
+	return missing
+}
+
+// mergeReferencedExporters adds the referenced objects to the exporters of the export so that references to them can be resolved
+func (g *GenesysCloudResourceExporter) mergeReferencedExporters(exporters map[string]*resourceExporter.ResourceExporter) {
+	if g.exporters == nil {
+		g.exporters = &map[string]*resourceExporter.ResourceExporter{}
+	}
+
+	for resType, exporter := range exporters {
+		existing, ok := (*g.exporters)[resType]
+		if !ok {
+			(*g.exporters)[resType] = exporter
+			continue
+		}
+		if existing.SanitizedResourceMap == nil {
+			existing.SanitizedResourceMap = make(resourceExporter.ResourceIDMetaMap)
+		}
+		for id, meta := range exporter.SanitizedResourceMap {
+			existing.SanitizedResourceMap[id] = meta
+		}
+	}
+}
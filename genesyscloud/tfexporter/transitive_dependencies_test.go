@@ -0,0 +1,74 @@
+package tfexporter
+
+import (
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportTransitiveDependencies(t *testing.T) {
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_routing_queue": {
+			RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+				"wrapup_codes":      {RefType: "genesyscloud_routing_wrapupcode"},
+				"skill_groups":      {RefType: "genesyscloud_routing_skill_group"},
+				"default_script_id": {RefType: "genesyscloud_script", AltValues: []string{"none"}},
+				"queue_flow_id":     {RefType: "genesyscloud_flow"},
+			},
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+				"queue-1": {Name: "queue_1"},
+			},
+		},
+		"genesyscloud_routing_wrapupcode": {
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+				"code-1": {Name: "code_1"},
+			},
+		},
+	}
+
+	g := &GenesysCloudResourceExporter{
+		exporters: &exporters,
+		resources: []resourceExporter.ResourceInfo{
+			{Name: "queue_1", Type: "genesyscloud_routing_queue", State: &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{
+				"wrapup_codes.#":    "3",
+				"wrapup_codes.1234": "code-1",
+				"wrapup_codes.5678": "code-3",
+				"wrapup_codes.9012": "code-2",
+				"default_script_id": "none",
+				"queue_flow_id":     "flow-1",
+				"skill_groups.#":    "1",
+				"skill_groups.3456": "group-1",
+				"acw_timeout_ms":    "300000",
+			}}},
+			{Name: "code_1", Type: "genesyscloud_routing_wrapupcode", State: &terraform.InstanceState{ID: "code-1"}},
+		},
+	}
+
+	// Only references to objects that are not exported are returned
+	attempted := map[string]bool{"genesyscloud_routing_skill_group::group-1": true}
+	missing := g.unexportedReferences(attempted)
+	assert.Equal(t, map[string][]string{
+		"genesyscloud_routing_wrapupcode": {"code-2", "code-3"},
+		"genesyscloud_flow":               {"flow-1"},
+	}, missing)
+
+	// Referenced objects are merged into the exporters so references to them resolve
+	g.mergeReferencedExporters(map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_routing_wrapupcode": {SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{"code-2": {Name: "code_2"}}},
+		"genesyscloud_flow":               {SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{"flow-1": {Name: "flow_1"}}},
+	})
+	assert.Len(t, exporters["genesyscloud_routing_wrapupcode"].SanitizedResourceMap, 2)
+	assert.Equal(t, "flow_1", exporters["genesyscloud_flow"].SanitizedResourceMap["flow-1"].Name)
+
+	g.resources = append(g.resources,
+		resourceExporter.ResourceInfo{Name: "code_2", Type: "genesyscloud_routing_wrapupcode", State: &terraform.InstanceState{ID: "code-2"}},
+		resourceExporter.ResourceInfo{Name: "flow_1", Type: "genesyscloud_flow", State: &terraform.InstanceState{ID: "flow-1"}},
+	)
+	attempted["genesyscloud_routing_wrapupcode::code-3"] = true
+	assert.Empty(t, g.unexportedReferences(attempted))
+
+	// Nothing is read when the mode is disabled
+	assert.Nil(t, g.exportTransitiveDependencies())
+}
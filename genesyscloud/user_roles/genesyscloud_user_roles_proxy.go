@@ -3,6 +3,7 @@ package user_roles
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,6 +13,9 @@ import (
 
 var internalProxy *userRolesProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[userRolesProxy]
+
 type getUserRolesByIdFunc func(ctx context.Context, p *userRolesProxy, roleId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error)
 type updateUserRolesFunc func(ctx context.Context, p *userRolesProxy, roleId string, rolesConfig *schema.Set, subjectType string) (*platformclientv2.APIResponse, error)
 
@@ -33,10 +37,10 @@ func newUserRolesProxy(clientConfig *platformclientv2.Configuration) *userRolesP
 }
 
 func getUserRolesProxy(clientConfig *platformclientv2.Configuration) *userRolesProxy {
-	if internalProxy == nil {
-		internalProxy = newUserRolesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newUserRolesProxy)
 }
 
 func (p *userRolesProxy) getUserRolesById(ctx context.Context, roleId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
@@ -72,7 +76,7 @@ func updateUserRolesFn(_ context.Context, p *userRolesProxy, roleId string, role
 	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(roleId, true)
 	grants, _, err := getAssignedGrants(*subject.Id, p)
 
-	existingGrants, configGrants, _ := getExistingAndConfigGrants(grants, rolesConfig)
+	existingGrants, configGrants, _ := getExistingAndConfigGrants(p, grants, rolesConfig)
 
 	if err != nil {
 		return resp, fmt.Errorf("failed to get current grants for subject %s: %s", roleId, err)
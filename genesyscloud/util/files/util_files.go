@@ -306,3 +306,17 @@ func WriteToFile(bytes []byte, path string) diag.Diagnostics {
 	}
 	return nil
 }
+
+// WriteToPrivateFile writes a file that only the current user can read and write. It is used for files that hold the state of
+// exported objects.
+func WriteToPrivateFile(bytes []byte, path string) diag.Diagnostics {
+	err := os.WriteFile(path, bytes, 0600)
+	if err == nil {
+		// The mode of an existing file is not changed by os.WriteFile
+		err = os.Chmod(path, 0600)
+	}
+	if err != nil {
+		return util.BuildDiagnosticError("File Writer", fmt.Sprintf("Error writing file with Path %s", path), err)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -201,3 +203,17 @@ func TestScriptUploadSuccess(t *testing.T) {
 		t.Errorf(`expected %s got %s`, scriptFile, resultsStr)
 	}
 }
+
+func TestUnitWriteToPrivateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	assert.Nil(t, os.WriteFile(path, []byte("{}"), 0644))
+
+	// An existing file is rewritten and restricted to the current user
+	assert.Nil(t, WriteToPrivateFile([]byte(`{"state": {}}`), path))
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	content, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"state": {}}`, string(content))
+}
@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// The rate a limiter never drops below after throttled responses
+	minRequestsPerSecond = 1.0
+
+	rateWindow = time.Second
+)
+
+// Limiter is a token bucket that limits the requests of the SDK clients of a pool. The rate starts at the configured maximum, or
+// unlimited, and adapts to the API: it is halved on every throttled (429) response and grows back by about one request per second
+// every second while requests succeed. Requests also wait while the rate limit headers of a response ask to back off.
+type Limiter struct {
+	mutex   sync.Mutex
+	maxRate float64
+	rate    float64
+	tokens  float64
+	last    time.Time
+
+	// Requests sent in the current and previous window, used to learn the rate the API allows
+	windowStart   time.Time
+	windowCount   int
+	previousCount int
+
+	throttle *Throttle
+	slots    chan struct{}
+	now      func() time.Time
+}
+
+// NewLimiter creates a limiter for at most maxRequestsPerSecond requests per second and maxConcurrentRequests concurrent
+// requests. A limit of 0 means no limit.
+func NewLimiter(maxRequestsPerSecond float64, maxConcurrentRequests int) *Limiter {
+	l := &Limiter{
+		maxRate:  maxRequestsPerSecond,
+		rate:     maxRequestsPerSecond,
+		throttle: NewThrottle(),
+		now:      time.Now,
+	}
+	if maxConcurrentRequests > 0 {
+		l.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+	return l
+}
+
+// Acquire blocks until fewer than the maximum concurrent requests are running or the context is done
+func (l *Limiter) Acquire(ctx context.Context) error {
+	if l.slots == nil {
+		return nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees the slot taken by Acquire
+func (l *Limiter) Release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// MaxConcurrentRequests returns the limit of concurrent requests. 0 means there is no limit.
+func (l *Limiter) MaxConcurrentRequests() int {
+	return cap(l.slots)
+}
+
+// Throttle returns the throttle fed by the rate limit headers of the responses observed by the limiter
+func (l *Limiter) Throttle() *Throttle {
+	return l.throttle
+}
+
+// Wait blocks until a request can be sent or the context is done
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := l.throttle.Wait(ctx); err != nil {
+		return err
+	}
+
+	for {
+		l.mutex.Lock()
+		wait := l.reserve()
+		l.mutex.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ObserveResponse adapts the rate to the status code and rate limit headers of an API response
+func (l *Limiter) ObserveResponse(response *http.Response) {
+	if response == nil {
+		return
+	}
+	l.throttle.ObserveResponse(response)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if response.StatusCode == http.StatusTooManyRequests {
+		// Halve the rate, or the rate the requests were sent at if there was no limit
+		rate := l.rate
+		if rate <= 0 {
+			rate = math.Max(float64(l.windowCount), float64(l.previousCount))
+		}
+		rate = math.Max(rate/2, minRequestsPerSecond)
+		if l.rate <= 0 || rate < l.rate {
+			log.Printf("Throttled by the API. Limiting requests to %.1f per second", rate)
+			l.rate = rate
+			l.tokens = 0
+		}
+		return
+	}
+
+	if response.StatusCode < http.StatusBadRequest && l.rate > 0 && (l.maxRate <= 0 || l.rate < l.maxRate) {
+		l.rate += 1 / l.rate
+		if l.maxRate > 0 && l.rate > l.maxRate {
+			l.rate = l.maxRate
+		}
+	}
+}
+
+// Rate returns the current limit of requests per second. 0 means there is no limit.
+func (l *Limiter) Rate() float64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.rate
+}
+
+// reserve takes a token for a request and returns how long to wait when none is available
+func (l *Limiter) reserve() time.Duration {
+	now := l.now()
+	if now.Sub(l.windowStart) >= rateWindow {
+		l.previousCount = l.windowCount
+		l.windowCount = 0
+		l.windowStart = now
+	}
+
+	if l.rate <= 0 {
+		l.windowCount++
+		return 0
+	}
+
+	// The bucket holds up to a second of requests
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.tokens+now.Sub(l.last).Seconds()*l.rate, math.Max(l.rate, 1))
+	} else {
+		l.tokens = math.Max(l.rate, 1)
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		l.windowCount++
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(maxRequestsPerSecond float64, maxConcurrentRequests int, now *time.Time) *Limiter {
+	limiter := NewLimiter(maxRequestsPerSecond, maxConcurrentRequests)
+	limiter.now = func() time.Time { return *now }
+	limiter.throttle.now = limiter.now
+	return limiter
+}
+
+func TestUnitLimiterReserve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(2, 0, &now)
+
+	// The bucket starts full
+	for i := 0; i < 2; i++ {
+		if wait := limiter.reserve(); wait != 0 {
+			t.Errorf("Expected request %d to be sent without waiting, got %v", i, wait)
+		}
+	}
+	if wait := limiter.reserve(); wait != 500*time.Millisecond {
+		t.Errorf("Expected to wait 500ms for a token, got %v", wait)
+	}
+
+	// Tokens refill at the rate
+	now = now.Add(500 * time.Millisecond)
+	if wait := limiter.reserve(); wait != 0 {
+		t.Errorf("Expected a refilled token, got wait %v", wait)
+	}
+
+	// Without a limit requests never wait
+	unlimited := newTestLimiter(0, 0, &now)
+	for i := 0; i < 100; i++ {
+		if wait := unlimited.reserve(); wait != 0 {
+			t.Errorf("Expected no wait without a limit, got %v", wait)
+		}
+	}
+}
+
+func TestUnitLimiterObserveResponse(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(10, 0, &now)
+
+	// Throttled responses halve the rate down to the minimum
+	limiter.ObserveResponse(newTestResponse(http.StatusTooManyRequests, map[string]string{}))
+	if limiter.Rate() != 5 {
+		t.Errorf("Expected rate 5 after a throttled response, got %v", limiter.Rate())
+	}
+	for i := 0; i < 5; i++ {
+		limiter.ObserveResponse(newTestResponse(http.StatusTooManyRequests, map[string]string{}))
+	}
+	if limiter.Rate() != minRequestsPerSecond {
+		t.Errorf("Expected rate %v after repeated throttled responses, got %v", minRequestsPerSecond, limiter.Rate())
+	}
+
+	// Successful responses raise the rate back up to the maximum
+	for i := 0; i < 100; i++ {
+		limiter.ObserveResponse(newTestResponse(http.StatusOK, map[string]string{}))
+	}
+	if limiter.Rate() != 10 {
+		t.Errorf("Expected rate to recover to 10, got %v", limiter.Rate())
+	}
+
+	// Without a maximum the rate is learned from the requests sent when first throttled
+	unlimited := newTestLimiter(0, 0, &now)
+	for i := 0; i < 40; i++ {
+		unlimited.reserve()
+	}
+	unlimited.ObserveResponse(newTestResponse(http.StatusTooManyRequests, map[string]string{headerRetryAfter: "2"}))
+	if unlimited.Rate() != 20 {
+		t.Errorf("Expected rate 20 after a throttled response, got %v", unlimited.Rate())
+	}
+	if expected := now.Add(2 * time.Second); !unlimited.throttle.pausedUntil.Equal(expected) {
+		t.Errorf("Expected pause until %v, got %v", expected, unlimited.throttle.pausedUntil)
+	}
+}
+
+func TestUnitLimiterConcurrency(t *testing.T) {
+	limiter := NewLimiter(0, 1)
+	if err := limiter.Acquire(context.Background()); err != nil {
+		t.Errorf("Expected a free slot, got %v", err)
+	}
+
+	// A second request waits until the slot is released
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected deadline exceeded waiting for a slot, got %v", err)
+	}
+
+	limiter.Release()
+	if err := limiter.Acquire(context.Background()); err != nil {
+		t.Errorf("Expected a released slot, got %v", err)
+	}
+	limiter.Release()
+}
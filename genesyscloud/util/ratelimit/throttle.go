@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate limit headers returned by the Genesys Cloud API
+const (
+	headerRetryAfter       = "Retry-After"
+	headerRateLimitAllowed = "inin-ratelimit-allowed"
+	headerRateLimitCount   = "inin-ratelimit-count"
+	headerRateLimitReset   = "inin-ratelimit-reset"
+)
+
+const (
+	// Callers are paused until the rate limit resets once this fraction of the allowed requests has been used
+	nearLimitThreshold = 0.9
+
+	defaultThrottlePause = time.Second
+	maxThrottlePause     = time.Minute
+)
+
+// Throttle tracks the rate limit state reported by the Genesys Cloud API so that long running
+// operations such as exports can back off before the SDK runs out of retries
+type Throttle struct {
+	mutex          sync.Mutex
+	pausedUntil    time.Time
+	throttledCount uint64
+	now            func() time.Time
+}
+
+func NewThrottle() *Throttle {
+	return &Throttle{now: time.Now}
+}
+
+// ObserveResponse updates the throttle from the status code and rate limit headers of an API response
+func (t *Throttle) ObserveResponse(response *http.Response) {
+	if response == nil {
+		return
+	}
+
+	var pause time.Duration
+	if response.StatusCode == http.StatusTooManyRequests {
+		pause = parseSeconds(response.Header.Get(headerRetryAfter))
+		if pause == 0 {
+			pause = parseSeconds(response.Header.Get(headerRateLimitReset))
+		}
+		if pause == 0 {
+			pause = defaultThrottlePause
+		}
+
+		t.mutex.Lock()
+		t.throttledCount++
+		t.mutex.Unlock()
+	} else if isNearLimit(response.Header) {
+		pause = parseSeconds(response.Header.Get(headerRateLimitReset))
+	}
+
+	if pause > 0 {
+		t.pauseFor(pause)
+	}
+}
+
+// Wait blocks until the throttle is no longer paused or the context is done
+func (t *Throttle) Wait(ctx context.Context) error {
+	for {
+		t.mutex.Lock()
+		remaining := t.pausedUntil.Sub(t.now())
+		t.mutex.Unlock()
+		if remaining <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(remaining)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ThrottledCount returns the number of throttled (429) responses observed
+func (t *Throttle) ThrottledCount() uint64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.throttledCount
+}
+
+func (t *Throttle) pauseFor(pause time.Duration) {
+	if pause > maxThrottlePause {
+		pause = maxThrottlePause
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	until := t.now().Add(pause)
+	if until.After(t.pausedUntil) {
+		log.Printf("Rate limit reached. Pausing requests for %v", pause)
+		t.pausedUntil = until
+	}
+}
+
+func isNearLimit(header http.Header) bool {
+	allowed, err := strconv.ParseFloat(header.Get(headerRateLimitAllowed), 64)
+	if err != nil || allowed <= 0 {
+		return false
+	}
+	count, err := strconv.ParseFloat(header.Get(headerRateLimitCount), 64)
+	if err != nil {
+		return false
+	}
+	return count >= allowed*nearLimitThreshold
+}
+
+func parseSeconds(value string) time.Duration {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
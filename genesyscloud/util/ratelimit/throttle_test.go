@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestResponse(statusCode int, headers map[string]string) *http.Response {
+	response := &http.Response{StatusCode: statusCode, Header: make(http.Header)}
+	for k, v := range headers {
+		response.Header.Set(k, v)
+	}
+	return response
+}
+
+func TestUnitThrottleObserveResponse(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttle := NewThrottle()
+	throttle.now = func() time.Time { return now }
+
+	// Responses well within the limit do not pause
+	throttle.ObserveResponse(newTestResponse(http.StatusOK, map[string]string{headerRateLimitAllowed: "300", headerRateLimitCount: "10", headerRateLimitReset: "30"}))
+	if !throttle.pausedUntil.IsZero() {
+		t.Errorf("Expected no pause, got pause until %v", throttle.pausedUntil)
+	}
+
+	// Responses near the limit pause until the reset
+	throttle.ObserveResponse(newTestResponse(http.StatusOK, map[string]string{headerRateLimitAllowed: "300", headerRateLimitCount: "290", headerRateLimitReset: "5"}))
+	if expected := now.Add(5 * time.Second); !throttle.pausedUntil.Equal(expected) {
+		t.Errorf("Expected pause until %v, got %v", expected, throttle.pausedUntil)
+	}
+
+	// Throttled responses honor Retry-After
+	throttle.ObserveResponse(newTestResponse(http.StatusTooManyRequests, map[string]string{headerRetryAfter: "20"}))
+	if expected := now.Add(20 * time.Second); !throttle.pausedUntil.Equal(expected) {
+		t.Errorf("Expected pause until %v, got %v", expected, throttle.pausedUntil)
+	}
+	if throttle.ThrottledCount() != 1 {
+		t.Errorf("Expected 1 throttled response, got %d", throttle.ThrottledCount())
+	}
+
+	// A shorter pause does not shorten an existing pause and pauses are capped
+	throttle.ObserveResponse(newTestResponse(http.StatusTooManyRequests, map[string]string{}))
+	if expected := now.Add(20 * time.Second); !throttle.pausedUntil.Equal(expected) {
+		t.Errorf("Expected pause until %v, got %v", expected, throttle.pausedUntil)
+	}
+	throttle.ObserveResponse(newTestResponse(http.StatusTooManyRequests, map[string]string{headerRetryAfter: "3600"}))
+	if expected := now.Add(maxThrottlePause); !throttle.pausedUntil.Equal(expected) {
+		t.Errorf("Expected pause until %v, got %v", expected, throttle.pausedUntil)
+	}
+}
+
+func TestUnitThrottleWait(t *testing.T) {
+	throttle := NewThrottle()
+	if err := throttle.Wait(context.Background()); err != nil {
+		t.Errorf("Expected no error waiting on an idle throttle, got %v", err)
+	}
+
+	throttle.pauseFor(50 * time.Millisecond)
+	start := time.Now()
+	if err := throttle.Wait(context.Background()); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected Wait to block for the pause, returned after %v", elapsed)
+	}
+
+	throttle.pauseFor(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := throttle.Wait(ctx); err == nil {
+		t.Error("Expected an error when the context is cancelled")
+	}
+}
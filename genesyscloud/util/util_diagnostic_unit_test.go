@@ -2,6 +2,8 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
 	"github.com/stretchr/testify/assert"
 	"net/http"
@@ -141,3 +143,22 @@ func TestUnitTestAPIResponseWithRetriesDiagWithBadApiResponse(t *testing.T) {
 	assert.Equal(t, sumErrMsg, lines[0])
 	assert.Equal(t, targetResponse, lines[1])
 }
+
+func TestUnitTestGetDiagnosticsStatusCode(t *testing.T) {
+	apiResponse := &platformclientv2.APIResponse{
+		Response:     &http.Response{Request: &http.Request{Method: "GET", URL: &url.URL{Path: "/api/v2/flows/1234"}}},
+		StatusCode:   http.StatusTooManyRequests,
+		ErrorMessage: "Rate limit exceeded",
+	}
+
+	// Diagnostics built from an API response
+	assert.Equal(t, http.StatusTooManyRequests, GetDiagnosticsStatusCode(BuildAPIDiagnosticError("genesyscloud_flow", "Failed to read flow", apiResponse)))
+
+	// Errors built for withRetries functions and converted back into diagnostics
+	retryErr := BuildWithRetriesApiDiagnosticError("genesyscloud_flow", "Failed to read flow", apiResponse)
+	assert.Equal(t, http.StatusTooManyRequests, GetDiagnosticsStatusCode(diag.FromErr(retryErr)))
+
+	// Diagnostics without an API response
+	assert.Equal(t, 0, GetDiagnosticsStatusCode(diag.Errorf("API Error: 429")))
+	assert.Equal(t, 0, GetDiagnosticsStatusCode(BuildDiagnosticError("genesyscloud_flow", "Failed to read flow", errors.New("timeout"))))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v129/platformclientv2"
+	"strings"
 )
 
 type detailedDiagnosticInfo struct {
@@ -80,3 +81,20 @@ func BuildWithRetriesApiDiagnosticError(resourceName string, summary string, api
 	}
 	return errors.New(errorMsg)
 }
+
+// GetDiagnosticsStatusCode returns the status code of the API response that diagnostics were built from with BuildAPIDiagnosticError
+// or BuildWithRetriesApiDiagnosticError. 0 is returned if the diagnostics do not hold an API response.
+func GetDiagnosticsStatusCode(diags diag.Diagnostics) int {
+	for _, d := range diags {
+		for _, line := range strings.Split(d.Summary+"\n"+d.Detail, "\n") {
+			var diagInfo detailedDiagnosticInfo
+			if !strings.HasPrefix(line, "{") || json.Unmarshal([]byte(line), &diagInfo) != nil {
+				continue
+			}
+			if diagInfo.StatusCode != 0 {
+				return diagInfo.StatusCode
+			}
+		}
+	}
+	return 0
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -14,10 +15,15 @@ import (
 
 type JsonMap map[string]interface{}
 
-// Attempt to get the home division once during a provider run
-var divOnce sync.Once
-var homeDivID string
-var homeDivErr diag.Diagnostics
+// Attempt to get the home division once per org during a provider run. Provider instances configured for different orgs
+// each have their own home division.
+type homeDivision struct {
+	once sync.Once
+	id   string
+	err  diag.Diagnostics
+}
+
+var homeDivisions sync.Map
 
 func GetHomeDivisionName(key string, divisionName *string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
@@ -30,21 +36,29 @@ func GetHomeDivisionName(key string, divisionName *string) resource.TestCheckFun
 	}
 }
 
+// GetHomeDivisionID returns the home division of the org of the default SDK configuration
 func GetHomeDivisionID() (string, diag.Diagnostics) {
-	divOnce.Do(func() {
-		authAPI := platformclientv2.NewAuthorizationApi()
+	return GetHomeDivisionIDWithConfig(platformclientv2.GetDefaultConfiguration())
+}
+
+// GetHomeDivisionIDWithConfig returns the home division of the org an SDK configuration is authorized for
+func GetHomeDivisionIDWithConfig(sdkConfig *platformclientv2.Configuration) (string, diag.Diagnostics) {
+	cached, _ := homeDivisions.LoadOrStore(provider.ClientConfigOrgKey(sdkConfig), &homeDivision{})
+	div := cached.(*homeDivision)
+	div.once.Do(func() {
+		authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
 		homeDiv, _, err := authAPI.GetAuthorizationDivisionsHome()
 		if err != nil {
-			homeDivErr = diag.Errorf("Failed to query home division: %s", err)
+			div.err = diag.Errorf("Failed to query home division: %s", err)
 			return
 		}
-		homeDivID = *homeDiv.Id
+		div.id = *homeDiv.Id
 	})
 
-	if homeDivErr != nil {
-		return "", homeDivErr
+	if div.err != nil {
+		return "", div.err
 	}
-	return homeDivID, nil
+	return div.id, nil
 }
 
 func UpdateObjectDivision(d *schema.ResourceData, objType string, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
@@ -53,11 +67,11 @@ func UpdateObjectDivision(d *schema.ResourceData, objType string, sdkConfig *pla
 		divisionID := d.Get("division_id").(string)
 		if divisionID == "" {
 			// Default to home division
-			homeDivision, diagErr := GetHomeDivisionID()
+			homeDivisionID, diagErr := GetHomeDivisionIDWithConfig(sdkConfig)
 			if diagErr != nil {
 				return diagErr
 			}
-			divisionID = homeDivision
+			divisionID = homeDivisionID
 		}
 		log.Printf("Updating division for %s %s to %s", objType, d.Id(), divisionID)
 		_, divErr := authAPI.PostAuthorizationDivisionObject(divisionID, objType, []string{d.Id()})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"time"
 
@@ -14,6 +15,9 @@ import (
 
 var internalProxy *webDeploymentsConfigurationProxy
 
+// proxyCache holds the proxy instance of each client config
+var proxyCache provider.ProxyCache[webDeploymentsConfigurationProxy]
+
 type getAllWebDeploymentsConfigurationFunc func(ctx context.Context, p *webDeploymentsConfigurationProxy) (*platformclientv2.Webdeploymentconfigurationversionentitylisting, *platformclientv2.APIResponse, error)
 type getWebdeploymentsConfigurationVersionFunc func(ctx context.Context, p *webDeploymentsConfigurationProxy, id string, version string) (*platformclientv2.Webdeploymentconfigurationversion, *platformclientv2.APIResponse, error)
 type determineLatestVersionFunc func(ctx context.Context, p *webDeploymentsConfigurationProxy, configurationId string) string
@@ -42,10 +46,10 @@ func newWebDeploymentsConfigurationProxy(clientConfig *platformclientv2.Configur
 }
 
 func getWebDeploymentConfigurationsProxy(clientConfig *platformclientv2.Configuration) *webDeploymentsConfigurationProxy {
-	if internalProxy == nil {
-		internalProxy = newWebDeploymentsConfigurationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyCache.Get(clientConfig, newWebDeploymentsConfigurationProxy)
 }
 
 type webDeploymentsConfigurationProxy struct {